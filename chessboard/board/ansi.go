@@ -0,0 +1,99 @@
+package board
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// ColorMode represent terminal color support.
+type ColorMode int
+
+const (
+	// NoColor writes plain square symbols.
+	NoColor ColorMode = iota
+	// Color256 paints squares with xterm 256-color palette.
+	Color256
+	// TrueColor paints squares with 24-bit colors.
+	TrueColor
+)
+
+const ansiReset = "\x1b[0m"
+
+// ANSIOptions represent terminal rendering options.
+type ANSIOptions struct {
+	Mode ColorMode
+	// CellWidth is a number of terminal columns per square,
+	// two columns make squares look square in most terminals.
+	CellWidth int
+	Light     color.RGBA
+	Dark      color.RGBA
+}
+
+// DefaultANSIOptions represent 24-bit terminal options with image board colors.
+var DefaultANSIOptions = ANSIOptions{
+	Mode:      TrueColor,
+	CellWidth: 2,
+	Light:     DefaultImageOptions.Light,
+	Dark:      DefaultImageOptions.Dark,
+}
+
+// sequence return escape sequence setting foreground or background color.
+func (m ColorMode) sequence(background bool, c color.RGBA) string {
+	layer := 38
+	if background {
+		layer = 48
+	}
+	if m == Color256 {
+		return fmt.Sprintf("\x1b[%d;5;%dm", layer, xterm256(c))
+	}
+	return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", layer, c.R, c.G, c.B)
+}
+
+// xterm256 return nearest color of xterm 6x6x6 color cube.
+func xterm256(c color.RGBA) int {
+	level := func(v uint8) int { return (int(v)*5 + 127) / 255 }
+	return 16 + 36*level(c.R) + 6*level(c.G) + level(c.B)
+}
+
+// WriteANSI write board with squares painted by terminal background colors,
+// with NoColor mode it writes plain board.
+func (br *Board) WriteANSI(w io.Writer, o ANSIOptions) error {
+	if o.Mode == NoColor {
+		return br.Write(w)
+	}
+	if o.CellWidth <= 0 {
+		return fmt.Errorf("ansi options:%w", ErrSize)
+	}
+	bw := bufio.NewWriter(w)
+	blank := strings.Repeat(" ", o.CellWidth)
+	left := strings.Repeat(" ", (o.CellWidth-1)/2)
+	right := strings.Repeat(" ", o.CellWidth-1-len(left))
+	for i, row := range br.Squares {
+		for j, r := range row {
+			bg := o.Light
+			if br.IsDark(i, j) {
+				bg = o.Dark
+			}
+			bw.WriteString(o.Mode.sequence(true, bg))
+			p := br.Piece(i, j)
+			if p == 0 {
+				bw.WriteString(blank)
+				continue
+			}
+			fg := white
+			if unicode.IsLower(p) {
+				fg = black
+			}
+			bw.WriteString(o.Mode.sequence(false, fg) + left + r + right)
+		}
+		bw.WriteString(ansiReset + "\n")
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("writing ansi board:%w", err)
+	}
+	return nil
+}
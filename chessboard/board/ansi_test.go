@@ -0,0 +1,61 @@
+package board
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_WriteANSI(t *testing.T) {
+	br, err := FromFEN("K1/1q", "*", " ", UnicodePieces)
+	assert.NoError(t, err)
+
+	light, dark := color.RGBA{R: 255, G: 255, B: 255}, color.RGBA{R: 1, G: 2, B: 3}
+	tests := []struct {
+		name      string
+		o         ANSIOptions
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"no color",
+			ANSIOptions{Mode: NoColor},
+			"♔*\n*♛\n", assert.NoError,
+		},
+		{
+			"true color",
+			ANSIOptions{Mode: TrueColor, CellWidth: 3, Light: light, Dark: dark},
+			"\x1b[48;2;255;255;255m\x1b[38;2;255;255;255m ♔ \x1b[48;2;1;2;3m   \x1b[0m\n" +
+				"\x1b[48;2;1;2;3m   \x1b[48;2;255;255;255m\x1b[38;2;0;0;0m ♛ \x1b[0m\n",
+			assert.NoError,
+		},
+		{
+			"256 colors",
+			ANSIOptions{Mode: Color256, CellWidth: 2, Light: light, Dark: dark},
+			"\x1b[48;5;231m\x1b[38;5;231m♔ \x1b[48;5;16m  \x1b[0m\n" +
+				"\x1b[48;5;16m  \x1b[48;5;231m\x1b[38;5;16m♛ \x1b[0m\n",
+			assert.NoError,
+		},
+		{
+			"invalid cell width",
+			ANSIOptions{Mode: TrueColor},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, br.WriteANSI(w, tt.o))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func Test_xterm256(t *testing.T) {
+	assert.Equal(t, 16, xterm256(color.RGBA{}))
+	assert.Equal(t, 231, xterm256(color.RGBA{R: 255, G: 255, B: 255}))
+	assert.Equal(t, 196, xterm256(color.RGBA{R: 255}))
+	assert.Equal(t, 180, xterm256(DefaultImageOptions.Dark))
+}
@@ -1,32 +1,40 @@
 package board
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 )
 
 var (
 	// BlackSymbol represent black square symbol
-	BlackSymbol = '*'
+	BlackSymbol = "*"
 	// WhiteSymbol represent white square symbol
-	WhiteSymbol = ' '
+	WhiteSymbol = " "
 
 	// ErrSize indicates that a value does not have the right syntax for the size type.
 	ErrSize = errors.New("size should be a positive integer")
+	// ErrSquare indicates that a value is not a name of board square.
+	ErrSquare = errors.New("square should be file letters and rank number inside board")
 )
 
-// Board represent chess board as rune matrix.
+// Board represent chess board as symbols matrix.
 type Board struct {
 	Height  int
 	Width   int
-	Squares [][]rune
+	Squares [][]string
+	// Pieces holds FEN letters of pieces placed on squares, zero for empty square.
+	// It is nil for board without pieces.
+	Pieces [][]rune
+	// Colors holds pattern color numbers of squares, it is nil for checker board
+	// with dark top left square.
+	Colors [][]int
 }
 
 // NewBoard creates new board with height and width sizes and black and whiter symbols.
-func NewBoard(height, width int, blackSymbol, whiteSymbol rune) (*Board, error) {
+func NewBoard(height, width int, blackSymbol, whiteSymbol string) (*Board, error) {
 	if height <= 0 || width <= 0 {
 		return nil, ErrSize
 	}
@@ -40,7 +48,7 @@ func NewBoard(height, width int, blackSymbol, whiteSymbol rune) (*Board, error)
 func (br *Board) String() string {
 	var b strings.Builder
 	for _, r := range br.Squares {
-		b.WriteString(string(r))
+		b.WriteString(strings.Join(r, ""))
 		b.WriteRune('\n')
 	}
 
@@ -49,27 +57,103 @@ func (br *Board) String() string {
 
 // Write write board to writer
 func (br *Board) Write(w io.Writer) error {
-	bw := bufio.NewWriter(w)
-	for j, row := range br.Squares {
-		for i := range row {
-			if _, err := bw.WriteRune(br.Squares[j][i]); err != nil {
-				return fmt.Errorf("writing board:%w", err)
-			}
+	return br.WriteText(w, TextOptions{})
+}
+
+// WriteText write board with text options to writer.
+func (br *Board) WriteText(w io.Writer, o TextOptions) error {
+	return writeText(w, br.Height, br.Width, func(row, col int) (string, bool) {
+		return br.Squares[row][col], br.Piece(row, col) != 0
+	}, o)
+}
+
+// IsDark indicate if square is dark, top left square of board is dark.
+// Squares of pattern boards are dark for even color numbers.
+func (br *Board) IsDark(row, col int) bool {
+	return br.Color(row, col)%2 == 0
+}
+
+// Color return pattern color number of square, zero for dark and one for light squares of checker board.
+func (br *Board) Color(row, col int) int {
+	if br.Colors != nil {
+		return br.Colors[row][col]
+	}
+	if isDark(row, col) {
+		return 0
+	}
+	return 1
+}
+
+func isDark(row, col int) bool {
+	return (row+col)%2 == 0
+}
+
+// pieceColors return colors of chess board with height where bottom left square a1 is dark,
+// nil when its top left square is dark too.
+func pieceColors(height, width int) [][]int {
+	if height%2 == 1 {
+		return nil
+	}
+	colors := make([][]int, height)
+	for i := range colors {
+		colors[i] = make([]int, width)
+		for j := range colors[i] {
+			colors[i][j] = (i + j + 1) % 2
 		}
-		if _, err := bw.WriteRune('\n'); err != nil {
-			return fmt.Errorf("writing board:%w", err)
+	}
+	return colors
+}
+
+// fileLabel return column name a..z, aa..az, ba.. for zero based column.
+func fileLabel(col int) string {
+	label := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		label = string(rune('a'+(col-1)%26)) + label
+	}
+	return label
+}
+
+// rankLabel return row rank number counted from bottom row of board with height.
+func rankLabel(height, row int) string {
+	return strconv.Itoa(height - row)
+}
+
+// ParseSquare return zero based row and column of square named like c3 or aa12
+// on board with height and width.
+func ParseSquare(height, width int, name string) (int, int, error) {
+	i := strings.IndexFunc(name, func(r rune) bool { return r < 'a' || r > 'z' })
+	if i <= 0 {
+		return 0, 0, fmt.Errorf("parse square %q:%w", name, ErrSquare)
+	}
+	// file letters only add to column, so column is checked while it can not overflow.
+	col := 0
+	for _, r := range name[:i] {
+		col = col*26 + int(r-'a') + 1
+		if col > width {
+			return 0, 0, fmt.Errorf("parse square %q:%w", name, ErrSquare)
 		}
 	}
-	return bw.Flush()
+	col--
+	rank, err := strconv.Atoi(name[i:])
+	if err != nil || name[i] == '+' || rank <= 0 || rank > height || col < 0 || col >= width {
+		return 0, 0, fmt.Errorf("parse square %q:%w", name, ErrSquare)
+	}
+	return height - rank, col, nil
+}
+
+// SquareName return name like c3 or aa12 of square at zero based row and column
+// of board with height.
+func SquareName(height, row, col int) string {
+	return fileLabel(col) + rankLabel(height, row)
 }
 
-func createSquares(height, width int, blackSymbol, whiteSymbol rune) [][]rune {
-	squares := make([][]rune, height)
-	var c, cc, n, nc rune
+func createSquares(height, width int, blackSymbol, whiteSymbol string) [][]string {
+	squares := make([][]string, height)
+	var c, cc, n, nc string
 	c, n = blackSymbol, whiteSymbol
 	for i := range squares {
 		c, cc, n, nc = n, n, c, c
-		squares[i] = make([]rune, width)
+		squares[i] = make([]string, width)
 		for j := range squares[i] {
 			cc, nc = nc, cc
 			squares[i][j] = cc
@@ -2,6 +2,8 @@ package board
 
 import (
 	"bytes"
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,8 +13,8 @@ func TestNewBoard(t *testing.T) {
 	type args struct {
 		height      int
 		width       int
-		blackSymbol rune
-		whiteSymbol rune
+		blackSymbol string
+		whiteSymbol string
 	}
 	tests := []struct {
 		name      string
@@ -23,17 +25,17 @@ func TestNewBoard(t *testing.T) {
 
 		{
 			"valid args",
-			args{height: 1, width: 2, blackSymbol: '*', whiteSymbol: ' '},
-			&Board{Height: 1, Width: 2, Squares: [][]rune{{'*', ' '}}}, assert.NoError,
+			args{height: 1, width: 2, blackSymbol: "*", whiteSymbol: " "},
+			&Board{Height: 1, Width: 2, Squares: [][]string{{"*", " "}}}, assert.NoError,
 		},
 		{
 			"negative height",
-			args{height: -1, width: 2, blackSymbol: '*', whiteSymbol: ' '},
+			args{height: -1, width: 2, blackSymbol: "*", whiteSymbol: " "},
 			nil, assert.Error,
 		},
 		{
 			"negative width",
-			args{height: 1, width: -2, blackSymbol: '*', whiteSymbol: ' '},
+			args{height: 1, width: -2, blackSymbol: "*", whiteSymbol: " "},
 			nil, assert.Error,
 		},
 	}
@@ -50,7 +52,7 @@ func TestBoard_String(t *testing.T) {
 	type fields struct {
 		height  int
 		width   int
-		squares [][]rune
+		squares [][]string
 	}
 	tests := []struct {
 		name   string
@@ -61,7 +63,7 @@ func TestBoard_String(t *testing.T) {
 			"valid field",
 			fields{
 				height: 2, width: 2,
-				squares: [][]rune{{'*', ' '}, {' ', '*'}},
+				squares: [][]string{{"*", " "}, {" ", "*"}},
 			}, "* \n *\n"},
 	}
 	for _, tt := range tests {
@@ -77,23 +79,23 @@ func TestBoard_String(t *testing.T) {
 }
 
 func Test_createSquares(t *testing.T) {
-	b, w := '*', ' '
+	b, w := "*", " "
 
 	type args struct {
 		height      int
 		width       int
-		blackSymbol rune
-		whiteSymbol rune
+		blackSymbol string
+		whiteSymbol string
 	}
 	tests := []struct {
 		name string
 		args args
-		want [][]rune
+		want [][]string
 	}{
 		{
 			"valid args",
 			args{height: 6, width: 4, blackSymbol: b, whiteSymbol: w},
-			[][]rune{
+			[][]string{
 				{b, w, b, w},
 				{w, b, w, b},
 				{b, w, b, w},
@@ -114,7 +116,7 @@ func TestBoard_Write(t *testing.T) {
 	type fields struct {
 		Height  int
 		Width   int
-		Squares [][]rune
+		Squares [][]string
 	}
 	tests := []struct {
 		name      string
@@ -126,7 +128,7 @@ func TestBoard_Write(t *testing.T) {
 			"valid field",
 			fields{
 				Height: 2, Width: 2,
-				Squares: [][]rune{{'*', ' '}, {' ', '*'}},
+				Squares: [][]string{{"*", " "}, {" ", "*"}},
 			},
 			"* \n *\n", assert.NoError,
 		},
@@ -144,3 +146,146 @@ func TestBoard_Write(t *testing.T) {
 		})
 	}
 }
+
+func Test_fileLabel(t *testing.T) {
+	tests := []struct {
+		col  int
+		want string
+	}{
+		{0, "a"}, {7, "h"}, {25, "z"}, {26, "aa"}, {51, "az"}, {52, "ba"}, {701, "zz"}, {702, "aaa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, fileLabel(tt.col))
+		})
+	}
+}
+
+func TestParseSquare(t *testing.T) {
+	type args struct {
+		height int
+		width  int
+		name   string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantRow   int
+		wantCol   int
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"bottom left", args{8, 8, "a1"}, 7, 0, assert.NoError},
+		{"top right", args{8, 8, "h8"}, 0, 7, assert.NoError},
+		{"two letters file", args{12, 30, "ab12"}, 0, 27, assert.NoError},
+		{"file outside board", args{8, 8, "i1"}, 0, 0, assert.Error},
+		{"rank outside board", args{8, 8, "a9"}, 0, 0, assert.Error},
+		{"zero rank", args{8, 8, "a0"}, 0, 0, assert.Error},
+		{"signed rank", args{8, 8, "a+1"}, 0, 0, assert.Error},
+		{"missing file", args{8, 8, "1"}, 0, 0, assert.Error},
+		{"missing rank", args{8, 8, "a"}, 0, 0, assert.Error},
+		{"last file of wide board", args{8, 702, "zz1"}, 7, 701, assert.NoError},
+		{"overflowing file", args{8, 8, "zzzzzzzzzzzzzzzzzzz1"}, 0, 0, assert.Error},
+		{"overflowing file on wide board", args{8, math.MaxInt32, "zzzzzzzzzzzzzzzzzzz1"}, 0, 0, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, col, err := ParseSquare(tt.args.height, tt.args.width, tt.args.name)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.wantRow, row)
+			assert.Equal(t, tt.wantCol, col)
+		})
+	}
+}
+
+func TestSquareName(t *testing.T) {
+	assert.Equal(t, "a1", SquareName(8, 7, 0))
+	assert.Equal(t, "h8", SquareName(8, 0, 7))
+	assert.Equal(t, "ab12", SquareName(12, 0, 27))
+}
+
+func TestBoard_IsDark(t *testing.T) {
+	br := &Board{Height: 2, Width: 2}
+	assert.True(t, br.IsDark(0, 0))
+	assert.False(t, br.IsDark(0, 1))
+	assert.False(t, br.IsDark(1, 0))
+	assert.True(t, br.IsDark(1, 1))
+	assert.Equal(t, "2", rankLabel(br.Height, 0))
+}
+
+func TestBoard_IsDark_pieces(t *testing.T) {
+	tests := []struct {
+		name string
+		fen  string
+	}{
+		{"start position", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR"},
+		{"odd height", "3/3/3"},
+		{"single rank", "K2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			br, err := FromFEN(tt.fen, "*", " ", ASCIIPieces)
+			assert.NoError(t, err)
+			row, col, err := ParseSquare(br.Height, br.Width, "a1")
+			assert.NoError(t, err)
+			assert.True(t, br.IsDark(row, col), "a1")
+			assert.NotEqual(t, br.IsDark(row, col), br.IsDark(row, col+1), "b1")
+			assert.Equal(t, br.Height%2 == 1, br.IsDark(0, 0), "top left")
+		})
+	}
+}
+
+func TestBoard_WriteText(t *testing.T) {
+	small, err := NewBoard(2, 3, "*", " ")
+	assert.NoError(t, err)
+	wide, err := NewBoard(10, 28, "*", " ")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		br    *Board
+		o     TextOptions
+		wantW string
+	}{
+		{"bare", small, TextOptions{}, "* *\n * \n"},
+		{"frame", small, TextOptions{Frame: true}, "┌───┐\n│* *│\n│ * │\n└───┘\n"},
+		{"labels", small, TextOptions{Labels: true}, "2 * *\n1  * \n  abc\n"},
+		{
+			"frame and labels", small, TextOptions{Frame: true, Labels: true},
+			"  ┌───┐\n2 │* *│\n1 │ * │\n  └───┘\n   abc\n",
+		},
+		{
+			"wide board labels", wide, TextOptions{Labels: true},
+			"10 * * * * * * * * * * * * * * \n" +
+				" 9  * * * * * * * * * * * * * *\n" +
+				" 8 * * * * * * * * * * * * * * \n" +
+				" 7  * * * * * * * * * * * * * *\n" +
+				" 6 * * * * * * * * * * * * * * \n" +
+				" 5  * * * * * * * * * * * * * *\n" +
+				" 4 * * * * * * * * * * * * * * \n" +
+				" 3  * * * * * * * * * * * * * *\n" +
+				" 2 * * * * * * * * * * * * * * \n" +
+				" 1  * * * * * * * * * * * * * *\n" +
+				"                             aa\n" +
+				"   abcdefghijklmnopqrstuvwxyzab\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			assert.NoError(t, tt.br.WriteText(w, tt.o))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func TestBoard_WriteText_writerError(t *testing.T) {
+	br, err := NewBoard(2, 2, "*", " ")
+	assert.NoError(t, err)
+	assert.Error(t, br.WriteText(errWriter{}, TextOptions{Frame: true}))
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("test")
+}
@@ -0,0 +1,33 @@
+package board
+
+import "fmt"
+
+// SetPiece places piece with FEN letter drawn with pieces set on square.
+func (br *Board) SetPiece(row, col int, letter rune, pieces PieceSet) error {
+	if !br.inside(row, col) {
+		return fmt.Errorf("set piece on %d,%d:%w", row, col, ErrSquare)
+	}
+	symbol, ok := pieces[letter]
+	if !ok {
+		return fmt.Errorf("set piece %q:%w", letter, ErrFEN)
+	}
+	br.setPiece(row, col, letter)
+	br.Squares[row][col] = string(symbol)
+	return nil
+}
+
+// SetSymbol draws square with symbol and removes piece from it.
+func (br *Board) SetSymbol(row, col int, symbol string) error {
+	if !br.inside(row, col) {
+		return fmt.Errorf("set symbol on %d,%d:%w", row, col, ErrSquare)
+	}
+	if br.Pieces != nil {
+		br.Pieces[row][col] = 0
+	}
+	br.Squares[row][col] = symbol
+	return nil
+}
+
+func (br *Board) inside(row, col int) bool {
+	return row >= 0 && row < br.Height && col >= 0 && col < br.Width
+}
@@ -0,0 +1,38 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_SetPiece(t *testing.T) {
+	br, err := NewBoard(2, 2, "#", ".")
+	assert.NoError(t, err)
+
+	assert.NoError(t, br.SetPiece(0, 1, 'Q', UnicodePieces))
+	assert.NoError(t, br.SetPiece(1, 0, 'n', UnicodePieces))
+	assert.Equal(t, "#♕\n♞#\n", br.String())
+	assert.Equal(t, "1Q/n1", br.FEN())
+
+	assert.Error(t, br.SetPiece(2, 0, 'Q', UnicodePieces))
+	assert.Error(t, br.SetPiece(0, -1, 'Q', UnicodePieces))
+	assert.Error(t, br.SetPiece(0, 0, 'x', UnicodePieces))
+	assert.Equal(t, "#♕\n♞#\n", br.String())
+}
+
+func TestBoard_SetSymbol(t *testing.T) {
+	br, err := FromFEN("1Q/n1", "#", ".", ASCIIPieces)
+	assert.NoError(t, err)
+
+	assert.NoError(t, br.SetSymbol(0, 1, "#"))
+	assert.NoError(t, br.SetSymbol(1, 1, "@"))
+	assert.Equal(t, ".#\nn@\n", br.String())
+	assert.Equal(t, "2/n1", br.FEN())
+
+	empty, err := NewBoard(1, 1, "#", ".")
+	assert.NoError(t, err)
+	assert.NoError(t, empty.SetSymbol(0, 0, "@"))
+	assert.Nil(t, empty.Pieces)
+	assert.Error(t, empty.SetSymbol(1, 0, "@"))
+}
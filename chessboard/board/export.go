@@ -0,0 +1,111 @@
+package board
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	// ErrLegend indicates that board squares have symbols a format can not represent.
+	ErrLegend = errors.New("squares without pieces should have legend symbols of their colors")
+)
+
+// boardJSON represent board JSON document.
+type boardJSON struct {
+	Height int `json:"height"`
+	Width  int `json:"width"`
+	// Symbols is a legend of symbols by color numbers.
+	Symbols []string `json:"symbols"`
+	Colors  [][]int  `json:"colors"`
+	// FEN is a piece placement of boards with pieces.
+	FEN string `json:"fen,omitempty"`
+}
+
+// Legend return symbols of squares without pieces by color numbers,
+// colors without such squares have empty symbols.
+func (br *Board) Legend() []string {
+	var symbols []string
+	found := make(map[int]bool)
+	for i, row := range br.Squares {
+		for j, s := range row {
+			c := br.Color(i, j)
+			for len(symbols) <= c {
+				symbols = append(symbols, "")
+			}
+			if !found[c] && br.Piece(i, j) == 0 {
+				symbols[c], found[c] = s, true
+			}
+		}
+	}
+	return symbols
+}
+
+// CheckSymbols return ErrLegend when square without piece has symbol other than
+// legend symbol of its color, like symbol drawn with SetSymbol, formats with legend lose it.
+func (br *Board) CheckSymbols() error {
+	legend := br.Legend()
+	for i, row := range br.Squares {
+		for j, s := range row {
+			if br.Piece(i, j) == 0 && s != legend[br.Color(i, j)] {
+				return fmt.Errorf("square %s symbol %q, want %q:%w", SquareName(br.Height, i, j), s, legend[br.Color(i, j)], ErrLegend)
+			}
+		}
+	}
+	return nil
+}
+
+// WriteJSON write board sizes, symbols legend, matrix of square color numbers
+// and piece placement as JSON document, it fails for board with squares drawn
+// with symbols other than legend symbols.
+func (br *Board) WriteJSON(w io.Writer) error {
+	if err := br.CheckSymbols(); err != nil {
+		return err
+	}
+	doc := boardJSON{
+		Height:  br.Height,
+		Width:   br.Width,
+		Symbols: br.Legend(),
+		Colors:  make([][]int, br.Height),
+	}
+	for i := range doc.Colors {
+		doc.Colors[i] = make([]int, br.Width)
+		for j := range doc.Colors[i] {
+			doc.Colors[i][j] = br.Color(i, j)
+		}
+	}
+	if br.Pieces != nil {
+		doc.FEN = br.FEN()
+	}
+	if err := json.NewEncoder(w).Encode(doc); err != nil {
+		return fmt.Errorf("writing json board:%w", err)
+	}
+	return nil
+}
+
+// WriteCSV write square color numbers one rank per record from the top rank,
+// first record is a header of file labels and every record starts with rank label.
+func (br *Board) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, br.Width+1)
+	record[0] = "rank"
+	for j := 0; j < br.Width; j++ {
+		record[j+1] = fileLabel(j)
+	}
+	cw.Write(record)
+	for i := 0; i < br.Height; i++ {
+		record[0] = rankLabel(br.Height, i)
+		for j := 0; j < br.Width; j++ {
+			record[j+1] = strconv.Itoa(br.Color(i, j))
+		}
+		cw.Write(record)
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing csv board:%w", err)
+	}
+	return nil
+}
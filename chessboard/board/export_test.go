@@ -0,0 +1,98 @@
+package board
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_Legend(t *testing.T) {
+	checker, err := NewBoard(2, 2, "#", ".")
+	assert.NoError(t, err)
+	rings, err := NewPatternBoard(5, 5, Rings(1), []string{"a", "b", "c"})
+	assert.NoError(t, err)
+	covered, err := FromFEN("k", "#", ".", ASCIIPieces)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"#", "."}, checker.Legend())
+	assert.Equal(t, []string{"a", "b", "c"}, rings.Legend())
+	assert.Equal(t, []string{""}, covered.Legend())
+}
+
+func TestBoard_CheckSymbols(t *testing.T) {
+	br, err := FromFEN("k2/3", "#", ".", ASCIIPieces)
+	assert.NoError(t, err)
+	assert.NoError(t, br.CheckSymbols())
+
+	assert.NoError(t, br.SetSymbol(1, 2, "@"))
+	assert.EqualError(t, br.CheckSymbols(), "square c1 symbol \"@\", want \"#\":"+ErrLegend.Error())
+	assert.ErrorIs(t, br.WriteJSON(&bytes.Buffer{}), ErrLegend)
+}
+
+func TestBoard_WriteJSON(t *testing.T) {
+	checker, err := NewBoard(2, 3, "*", " ")
+	assert.NoError(t, err)
+	pieces, err := FromFEN("k1/1K", "#", ".", UnicodePieces)
+	assert.NoError(t, err)
+	stripes, err := NewPatternBoard(3, 1, Stripes(1), []string{"██", "  ", "░░"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		br    *Board
+		wantW string
+	}{
+		{
+			"checker", checker,
+			`{"height":2,"width":3,"symbols":["*"," "],"colors":[[0,1,0],[1,0,1]]}` + "\n",
+		},
+		{
+			"pieces", pieces,
+			`{"height":2,"width":2,"symbols":["#",""],"colors":[[1,0],[0,1]],"fen":"k1/1K"}` + "\n",
+		},
+		{
+			"pattern", stripes,
+			`{"height":3,"width":1,"symbols":["██","  ","░░"],"colors":[[0],[1],[2]]}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			assert.NoError(t, tt.br.WriteJSON(w))
+			assert.Equal(t, tt.wantW, w.String())
+			assert.True(t, json.Valid(w.Bytes()))
+		})
+	}
+}
+
+func TestBoard_WriteCSV(t *testing.T) {
+	checker, err := NewBoard(2, 3, "*", " ")
+	assert.NoError(t, err)
+	rings, err := NewPatternBoard(3, 3, Rings(1), []string{"a", "b"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		br    *Board
+		wantW string
+	}{
+		{"checker", checker, "rank,a,b,c\n2,0,1,0\n1,1,0,1\n"},
+		{"pattern", rings, "rank,a,b,c\n3,0,0,0\n2,0,1,0\n1,0,0,0\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			assert.NoError(t, tt.br.WriteCSV(w))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func TestBoard_WriteCSV_error(t *testing.T) {
+	br, err := NewBoard(1, 1, "*", " ")
+	assert.NoError(t, err)
+	assert.Error(t, br.WriteCSV(errWriter{}))
+	assert.Error(t, br.WriteJSON(errWriter{}))
+}
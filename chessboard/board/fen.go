@@ -0,0 +1,139 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxFENWidth is the widest rank of FEN piece placement, it bounds empty squares numbers.
+const maxFENWidth = 1024
+
+// PieceSet maps FEN piece letters to the symbols they are drawn with.
+type PieceSet map[rune]rune
+
+var (
+	// ASCIIPieces draws pieces with their FEN letters.
+	ASCIIPieces = PieceSet{
+		'K': 'K', 'Q': 'Q', 'R': 'R', 'B': 'B', 'N': 'N', 'P': 'P',
+		'k': 'k', 'q': 'q', 'r': 'r', 'b': 'b', 'n': 'n', 'p': 'p',
+	}
+	// UnicodePieces draws pieces with unicode chess glyphs.
+	UnicodePieces = PieceSet{
+		'K': '♔', 'Q': '♕', 'R': '♖', 'B': '♗', 'N': '♘', 'P': '♙',
+		'k': '♚', 'q': '♛', 'r': '♜', 'b': '♝', 'n': '♞', 'p': '♟',
+	}
+
+	// ErrFEN indicates that a value is not a valid FEN piece placement.
+	ErrFEN = errors.New("invalid FEN piece placement")
+)
+
+// FromFEN creates board with pieces from the placement field of fen string
+// drawn with pieces set over black and white symbols checker with dark a1 square.
+func FromFEN(fen string, blackSymbol, whiteSymbol string, pieces PieceSet) (*Board, error) {
+	fields := strings.Fields(fen)
+	if len(fields) == 0 {
+		return nil, ErrFEN
+	}
+	placement, err := parsePlacement(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	return NewPieceBoard(placement, blackSymbol, whiteSymbol, pieces)
+}
+
+// NewPieceBoard creates board with placement of FEN piece letters by rows, zero for empty square,
+// drawn with pieces set over black and white symbols checker with dark a1 square like chess board.
+func NewPieceBoard(placement [][]rune, blackSymbol, whiteSymbol string, pieces PieceSet) (*Board, error) {
+	if len(placement) == 0 || len(placement[0]) == 0 {
+		return nil, ErrSize
+	}
+	height, width := len(placement), len(placement[0])
+	colors := pieceColors(height, width)
+	if colors != nil {
+		blackSymbol, whiteSymbol = whiteSymbol, blackSymbol
+	}
+	squares := createSquares(height, width, blackSymbol, whiteSymbol)
+	for i, rank := range placement {
+		if len(rank) != width {
+			return nil, fmt.Errorf("rank %d width %d:%w", height-i, len(rank), ErrSize)
+		}
+		for j, p := range rank {
+			if p == 0 {
+				continue
+			}
+			symbol, ok := pieces[p]
+			if !ok {
+				return nil, fmt.Errorf("rank %d piece %q:%w", height-i, p, ErrFEN)
+			}
+			squares[i][j] = string(symbol)
+		}
+	}
+
+	return &Board{Height: height, Width: width, Squares: squares, Pieces: placement, Colors: colors}, nil
+}
+
+// FEN return placement field of board pieces in Forsyth–Edwards Notation.
+func (br *Board) FEN() string {
+	var b strings.Builder
+	for i := 0; i < br.Height; i++ {
+		if i > 0 {
+			b.WriteRune('/')
+		}
+		empty := 0
+		for j := 0; j < br.Width; j++ {
+			p := br.Piece(i, j)
+			if p == 0 {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				fmt.Fprint(&b, empty)
+				empty = 0
+			}
+			b.WriteRune(p)
+		}
+		if empty > 0 {
+			fmt.Fprint(&b, empty)
+		}
+	}
+
+	return b.String()
+}
+
+// Piece return FEN letter of piece on the square or zero for empty square.
+func (br *Board) Piece(row, col int) rune {
+	if br.Pieces == nil {
+		return 0
+	}
+	return br.Pieces[row][col]
+}
+
+func parsePlacement(placement string) ([][]rune, error) {
+	ranks := strings.Split(placement, "/")
+	pieces := make([][]rune, len(ranks))
+	for i, rank := range ranks {
+		empty := 0
+		for _, c := range rank {
+			if c >= '0' && c <= '9' {
+				empty = empty*10 + int(c-'0')
+				if len(pieces[i])+empty > maxFENWidth {
+					return nil, fmt.Errorf("rank %d wider than %d:%w", len(ranks)-i, maxFENWidth, ErrFEN)
+				}
+				continue
+			}
+			pieces[i] = append(pieces[i], make([]rune, empty)...)
+			empty = 0
+			pieces[i] = append(pieces[i], c)
+			if len(pieces[i]) > maxFENWidth {
+				return nil, fmt.Errorf("rank %d wider than %d:%w", len(ranks)-i, maxFENWidth, ErrFEN)
+			}
+		}
+		pieces[i] = append(pieces[i], make([]rune, empty)...)
+		if len(pieces[i]) == 0 || len(pieces[i]) != len(pieces[0]) {
+			return nil, fmt.Errorf("rank %d width %d:%w", len(ranks)-i, len(pieces[i]), ErrFEN)
+		}
+	}
+
+	return pieces, nil
+}
@@ -0,0 +1,131 @@
+package board
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromFEN(t *testing.T) {
+	b, w := "*", " "
+
+	type args struct {
+		fen    string
+		pieces PieceSet
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *Board
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"ascii pieces",
+			args{"k1/1P w - - 0 1", ASCIIPieces},
+			&Board{
+				Height: 2, Width: 2,
+				Squares: [][]string{{"k", b}, {b, "P"}},
+				Pieces:  [][]rune{{'k', 0}, {0, 'P'}},
+				Colors:  [][]int{{1, 0}, {0, 1}},
+			}, assert.NoError,
+		},
+		{
+			"unicode pieces",
+			args{"2/q1", UnicodePieces},
+			&Board{
+				Height: 2, Width: 2,
+				Squares: [][]string{{w, b}, {"♛", w}},
+				Pieces:  [][]rune{{0, 0}, {'q', 0}},
+				Colors:  [][]int{{1, 0}, {0, 1}},
+			}, assert.NoError,
+		},
+		{
+			"multi digit empty squares",
+			args{"10/R9", ASCIIPieces},
+			&Board{
+				Height: 2, Width: 10,
+				Squares: [][]string{
+					{w, b, w, b, w, b, w, b, w, b},
+					{"R", w, b, w, b, w, b, w, b, w},
+				},
+				Pieces: [][]rune{
+					{0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+					{'R', 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				},
+				Colors: [][]int{
+					{1, 0, 1, 0, 1, 0, 1, 0, 1, 0},
+					{0, 1, 0, 1, 0, 1, 0, 1, 0, 1},
+				},
+			}, assert.NoError,
+		},
+		{
+			"odd height",
+			args{"1k1/3/K2", ASCIIPieces},
+			&Board{
+				Height: 3, Width: 3,
+				Squares: [][]string{{b, "k", b}, {w, b, w}, {"K", w, b}},
+				Pieces:  [][]rune{{0, 'k', 0}, {0, 0, 0}, {'K', 0, 0}},
+			}, assert.NoError,
+		},
+		{"empty fen", args{"", ASCIIPieces}, nil, assert.Error},
+		{"different rank width", args{"8/7", ASCIIPieces}, nil, assert.Error},
+		{"empty rank", args{"8//8", ASCIIPieces}, nil, assert.Error},
+		{"unknown piece", args{"x7", ASCIIPieces}, nil, assert.Error},
+		{"huge empty squares number", args{"99999999999999999999", ASCIIPieces}, nil, assert.Error},
+		{"too wide rank", args{"1024/1P1023", ASCIIPieces}, nil, assert.Error},
+		{"too many pieces", args{strings.Repeat("P", maxFENWidth+1), ASCIIPieces}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromFEN(tt.args.fen, b, w, tt.args.pieces)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewPieceBoard(t *testing.T) {
+	br, err := NewPieceBoard([][]rune{{'N', 0}, {0, 0}}, "#", ".", UnicodePieces)
+	assert.NoError(t, err)
+	assert.Equal(t, "♘#\n#.\n", br.String())
+	assert.Equal(t, "N1/2", br.FEN())
+
+	_, err = NewPieceBoard(nil, "#", ".", ASCIIPieces)
+	assert.Error(t, err)
+	_, err = NewPieceBoard([][]rune{{0, 0}, {0}}, "#", ".", ASCIIPieces)
+	assert.Error(t, err)
+	_, err = NewPieceBoard([][]rune{{'x'}}, "#", ".", ASCIIPieces)
+	assert.Error(t, err)
+}
+
+func TestFromFEN_start(t *testing.T) {
+	fen := "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+	got, err := FromFEN(fen, "*", " ", ASCIIPieces)
+	assert.NoError(t, err)
+	assert.Equal(t, "rnbqkbnr\npppppppp\n * * * *\n* * * * \n * * * *\n* * * * \nPPPPPPPP\nRNBQKBNR\n", got.String())
+}
+
+func TestBoard_FEN(t *testing.T) {
+	tests := []struct {
+		name string
+		fen  string
+		want string
+	}{
+		{"start position", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR"},
+		{"wide board", "12/3K8", "12/3K8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			br, err := FromFEN(tt.fen, "*", " ", ASCIIPieces)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, br.FEN())
+		})
+	}
+}
+
+func TestBoard_FEN_noPieces(t *testing.T) {
+	br, err := NewBoard(2, 3, "*", " ")
+	assert.NoError(t, err)
+	assert.Equal(t, "3/3", br.FEN())
+}
@@ -0,0 +1,207 @@
+package board
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"unicode"
+)
+
+// ImageOptions represent board image rendering options.
+type ImageOptions struct {
+	// SquareSize is a square side in pixels.
+	SquareSize int
+	Light      color.RGBA
+	Dark       color.RGBA
+	// Border is a frame width in pixels around squares,
+	// with coordinates it is at least half of square size.
+	Border      int
+	BorderColor color.RGBA
+	Coordinates bool
+}
+
+// DefaultImageOptions represent image options with classic board colors.
+var DefaultImageOptions = ImageOptions{
+	SquareSize:  45,
+	Light:       color.RGBA{R: 0xf0, G: 0xd9, B: 0xb5, A: 0xff},
+	Dark:        color.RGBA{R: 0xb5, G: 0x88, B: 0x63, A: 0xff},
+	BorderColor: color.RGBA{R: 0x40, G: 0x40, B: 0x40, A: 0xff},
+}
+
+var (
+	white = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	black = color.RGBA{A: 0xff}
+)
+
+func (o *ImageOptions) validate() error {
+	if o.SquareSize <= 0 || o.Border < 0 {
+		return fmt.Errorf("image options:%w", ErrSize)
+	}
+	return nil
+}
+
+func (o *ImageOptions) margin() int {
+	if o.Coordinates && o.Border < o.SquareSize/2 {
+		return o.SquareSize / 2
+	}
+	return o.Border
+}
+
+func (o *ImageOptions) squareColor(br *Board, row, col int) color.RGBA {
+	if br.IsDark(row, col) {
+		return o.Dark
+	}
+	return o.Light
+}
+
+// WriteSVG write board as SVG image to writer.
+func (br *Board) WriteSVG(w io.Writer, o ImageOptions) error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+	m, s := o.margin(), o.SquareSize
+	width, height := br.Width*s+2*m, br.Height*s+2*m
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n",
+		width, height, width, height)
+	if m > 0 {
+		fmt.Fprintf(bw, "<rect width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", width, height, hexColor(o.BorderColor))
+	}
+	for i := 0; i < br.Height; i++ {
+		for j := 0; j < br.Width; j++ {
+			x, y := m+j*s, m+i*s
+			fmt.Fprintf(bw, "<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n",
+				x, y, s, s, hexColor(o.squareColor(br, i, j)))
+			if p := br.Piece(i, j); p != 0 {
+				writeSVGText(bw, x+s/2, y+s/2, s*4/5, black, string(pieceGlyph(p)))
+			}
+		}
+	}
+	if o.Coordinates {
+		for j := 0; j < br.Width; j++ {
+			writeSVGText(bw, m+j*s+s/2, height-m/2, m*3/5, o.Light, fileLabel(j))
+		}
+		for i := 0; i < br.Height; i++ {
+			writeSVGText(bw, m/2, m+i*s+s/2, m*3/5, o.Light, rankLabel(br.Height, i))
+		}
+	}
+	fmt.Fprintln(bw, "</svg>")
+
+	return bw.Flush()
+}
+
+func writeSVGText(w io.Writer, x, y, size int, c color.RGBA, text string) {
+	fmt.Fprintf(w, "<text x=\"%d\" y=\"%d\" font-size=\"%d\" fill=\"%s\" "+
+		"text-anchor=\"middle\" dominant-baseline=\"central\">%s</text>\n", x, y, size, hexColor(c), text)
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func pieceGlyph(p rune) rune {
+	if g, ok := UnicodePieces[p]; ok {
+		return g
+	}
+	return p
+}
+
+// WritePNG write board as PNG image to writer.
+func (br *Board) WritePNG(w io.Writer, o ImageOptions) error {
+	img, err := br.Image(o)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf("encoding png:%w", err)
+	}
+	return nil
+}
+
+// Image return board drawn as raster image.
+func (br *Board) Image(o ImageOptions) (*image.RGBA, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	m, s := o.margin(), o.SquareSize
+	img := image.NewRGBA(image.Rect(0, 0, br.Width*s+2*m, br.Height*s+2*m))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: o.BorderColor}, image.Point{}, draw.Src)
+	for i := 0; i < br.Height; i++ {
+		for j := 0; j < br.Width; j++ {
+			r := image.Rect(m+j*s, m+i*s, m+(j+1)*s, m+(i+1)*s)
+			draw.Draw(img, r, &image.Uniform{C: o.squareColor(br, i, j)}, image.Point{}, draw.Src)
+			if p := br.Piece(i, j); p != 0 {
+				drawPiece(img, r, p)
+			}
+		}
+	}
+	if o.Coordinates {
+		scale := max(1, m*3/5/glyphHeight)
+		for j := 0; j < br.Width; j++ {
+			drawText(img, m+j*s+s/2, img.Bounds().Dy()-m/2, scale, o.Light, fileLabel(j))
+		}
+		for i := 0; i < br.Height; i++ {
+			drawText(img, m/2, m+i*s+s/2, scale, o.Light, rankLabel(br.Height, i))
+		}
+	}
+
+	return img, nil
+}
+
+// drawPiece draws piece as a disc of its color with outlined letter.
+func drawPiece(img *image.RGBA, r image.Rectangle, p rune) {
+	fill, outline := white, black
+	if unicode.IsLower(p) {
+		fill, outline = black, white
+	}
+	s := r.Dx()
+	cx, cy := float64(r.Min.X)+float64(s)/2, float64(r.Min.Y)+float64(s)/2
+	radius, edge := float64(s)*2/5, math.Max(1, float64(s)/30)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			d := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+			switch {
+			case d <= radius-edge:
+				img.SetRGBA(x, y, fill)
+			case d <= radius:
+				img.SetRGBA(x, y, outline)
+			}
+		}
+	}
+	drawText(img, r.Min.X+s/2, r.Min.Y+s/2, max(1, s*2/5/glyphHeight), outline, string(unicode.ToUpper(p)))
+}
+
+// drawText draws text centered at x and y with font scaled by scale.
+func drawText(img *image.RGBA, x, y, scale int, c color.RGBA, text string) {
+	runes := []rune(text)
+	width := (len(runes)*(glyphWidth+1) - 1) * scale
+	x, y = x-width/2, y-glyphHeight*scale/2
+	for _, ch := range runes {
+		g, ok := glyphs[ch]
+		if ok {
+			for gy, row := range g {
+				for gx, dot := range row {
+					if dot != '#' {
+						continue
+					}
+					r := image.Rect(x+gx*scale, y+gy*scale, x+(gx+1)*scale, y+(gy+1)*scale)
+					draw.Draw(img, r, &image.Uniform{C: c}, image.Point{}, draw.Src)
+				}
+			}
+		}
+		x += (glyphWidth + 1) * scale
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,131 @@
+package board
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_WriteSVG(t *testing.T) {
+	withPiece, err := FromFEN("k1", "*", " ", ASCIIPieces)
+	assert.NoError(t, err)
+
+	o := DefaultImageOptions
+	o.SquareSize = 10
+	framed := o
+	framed.Border, framed.Coordinates = 2, true
+
+	tests := []struct {
+		name      string
+		br        *Board
+		o         ImageOptions
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"piece",
+			withPiece, o,
+			"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"20\" height=\"10\" viewBox=\"0 0 20 10\">\n" +
+				"<rect x=\"0\" y=\"0\" width=\"10\" height=\"10\" fill=\"#b58863\"/>\n" +
+				"<text x=\"5\" y=\"5\" font-size=\"8\" fill=\"#000000\" " +
+				"text-anchor=\"middle\" dominant-baseline=\"central\">♚</text>\n" +
+				"<rect x=\"10\" y=\"0\" width=\"10\" height=\"10\" fill=\"#f0d9b5\"/>\n" +
+				"</svg>\n",
+			assert.NoError,
+		},
+		{
+			"coordinates",
+			&Board{Height: 1, Width: 1, Squares: [][]string{{"*"}}}, framed,
+			"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"20\" height=\"20\" viewBox=\"0 0 20 20\">\n" +
+				"<rect width=\"20\" height=\"20\" fill=\"#404040\"/>\n" +
+				"<rect x=\"5\" y=\"5\" width=\"10\" height=\"10\" fill=\"#b58863\"/>\n" +
+				"<text x=\"10\" y=\"18\" font-size=\"3\" fill=\"#f0d9b5\" " +
+				"text-anchor=\"middle\" dominant-baseline=\"central\">a</text>\n" +
+				"<text x=\"2\" y=\"10\" font-size=\"3\" fill=\"#f0d9b5\" " +
+				"text-anchor=\"middle\" dominant-baseline=\"central\">1</text>\n" +
+				"</svg>\n",
+			assert.NoError,
+		},
+		{
+			"invalid square size",
+			withPiece, ImageOptions{},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, tt.br.WriteSVG(w, tt.o))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func TestBoard_WritePNG(t *testing.T) {
+	br, err := FromFEN("K1/1q", "*", " ", ASCIIPieces)
+	assert.NoError(t, err)
+	o := DefaultImageOptions
+	o.SquareSize, o.Border, o.Coordinates = 30, 4, true
+
+	w := &bytes.Buffer{}
+	assert.NoError(t, br.WritePNG(w, o))
+	img, err := png.Decode(w)
+	assert.NoError(t, err)
+
+	m := o.SquareSize / 2
+	assert.Equal(t, 2*30+2*m, img.Bounds().Dx())
+	assert.Equal(t, 2*30+2*m, img.Bounds().Dy())
+
+	rgba := func(x, y int) color.RGBA {
+		return color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+	}
+	assert.Equal(t, o.BorderColor, rgba(0, 0))
+	// a8 square is light and a1 square is dark.
+	assert.Equal(t, o.Light, rgba(m+1, m+1))
+	assert.Equal(t, o.Dark, rgba(m+30+1, m+1))
+	assert.Equal(t, o.Dark, rgba(m+1, m+30+1))
+	assert.Equal(t, white, rgba(m+15-9, m+15))
+	assert.Equal(t, black, rgba(m+30+15-9, m+30+15))
+}
+
+func TestBoard_WritePNG_invalidOptions(t *testing.T) {
+	br, err := NewBoard(1, 1, "*", " ")
+	assert.NoError(t, err)
+	w := &bytes.Buffer{}
+	assert.Error(t, br.WritePNG(w, ImageOptions{SquareSize: 1, Border: -1}))
+	assert.Empty(t, w.String())
+}
+
+func Test_drawText(t *testing.T) {
+	br, err := NewBoard(1, 1, "*", " ")
+	assert.NoError(t, err)
+	o := ImageOptions{SquareSize: 20, Light: white, Dark: black}
+	img, err := br.Image(o)
+	assert.NoError(t, err)
+	drawText(img, 10, 10, 1, white, "1?")
+
+	var b strings.Builder
+	for y := 6; y < 14; y++ {
+		for x := 4; x < 16; x++ {
+			if img.RGBAAt(x, y) == white {
+				b.WriteRune('#')
+				continue
+			}
+			b.WriteRune('.')
+		}
+		b.WriteRune('\n')
+	}
+	assert.Equal(t, ""+
+		"............\n"+
+		"...#........\n"+
+		"..##........\n"+
+		"...#........\n"+
+		"...#........\n"+
+		"...#........\n"+
+		"...#........\n"+
+		"..###.......\n", b.String())
+}
@@ -0,0 +1,166 @@
+package board
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxLineSize is the longest text board line Parse reads.
+const maxLineSize = 16 * 1024 * 1024
+
+var (
+	// ErrText indicates that a text is not a board written by Write.
+	ErrText = errors.New("malformed text board")
+)
+
+// ParseOptions represent text board parsing options.
+type ParseOptions struct {
+	// Pieces reads squares with FEN letters and unicode chess glyphs as pieces, board with pieces
+	// has checker with dark a1 square like NewPieceBoard, otherwise they are square symbols like any other.
+	Pieces bool
+	// AnySymbols reads cells with any symbols like squares drawn with SetSymbol,
+	// otherwise cells without pieces should form checker of two symbols.
+	AnySymbols bool
+	// CellWidth is a number of characters every square is written with, zero means one character.
+	// Pieces are read from cells with piece symbol surrounded by spaces.
+	CellWidth int
+}
+
+// Parse reads board written one square cell per line by Write or WriteText without frame and labels.
+// Sizes and black and white symbols are inferred from the checker of top left dark square
+// or of bottom left dark square when pieces are read.
+func Parse(r io.Reader, o ParseOptions) (*Board, error) {
+	if o.CellWidth < 0 {
+		return nil, fmt.Errorf("parse options:%w", ErrSize)
+	}
+	cellWidth := max(1, o.CellWidth)
+	var rows [][]string
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for s.Scan() {
+		text := []rune(strings.TrimSuffix(s.Text(), "\r"))
+		line := len(rows) + 1
+		switch {
+		case len(text) == 0:
+			return nil, fmt.Errorf("line %d is empty:%w", line, ErrText)
+		case len(text)%cellWidth != 0:
+			return nil, fmt.Errorf("line %d width %d, want cells of %d:%w", line, len(text), cellWidth, ErrText)
+		case line > 1 && len(text)/cellWidth != len(rows[0]):
+			return nil, fmt.Errorf("line %d width %d, want %d:%w", line, len(text), len(rows[0])*cellWidth, ErrText)
+		}
+		row := make([]string, len(text)/cellWidth)
+		for j := range row {
+			row[j] = string(text[j*cellWidth : (j+1)*cellWidth])
+		}
+		rows = append(rows, row)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("reading text board line %d:%w", len(rows)+1, err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no lines:%w", ErrText)
+	}
+
+	br := &Board{Height: len(rows), Width: len(rows[0]), Squares: make([][]string, len(rows))}
+	if o.Pieces && hasPieces(rows) {
+		br.Colors = pieceColors(br.Height, br.Width)
+	}
+	black, white := inferSymbols(rows, o.Pieces, br.IsDark)
+	for i, row := range rows {
+		br.Squares[i] = make([]string, len(row))
+		for j, c := range row {
+			br.Squares[i][j] = c
+			if symbol, p := cellPiece(c); o.Pieces && p != 0 {
+				br.Squares[i][j] = symbol
+				br.setPiece(i, j, p)
+				continue
+			}
+			want := white
+			if br.IsDark(i, j) {
+				want = black
+			}
+			if c != want && !o.AnySymbols {
+				return nil, fmt.Errorf("line %d column %d symbol %q, want %q:%w", i+1, j*cellWidth+1, c, want, ErrText)
+			}
+		}
+	}
+	return br, nil
+}
+
+// cellPiece return piece symbol and FEN letter of cell with piece symbol centered by WriteText,
+// zero letter for other cells.
+func cellPiece(cell string) (string, rune) {
+	runes := []rune(cell)
+	left := (len(runes) - 1) / 2
+	if strings.TrimSpace(cell) != string(runes[left]) {
+		return "", 0
+	}
+	return string(runes[left]), pieceLetter(runes[left])
+}
+
+// hasPieces indicate if any cell has piece symbol.
+func hasPieces(rows [][]string) bool {
+	for _, row := range rows {
+		for _, c := range row {
+			if _, p := cellPiece(c); p != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inferSymbols return symbols of first cells without pieces dark and light by isDark,
+// default symbols are used for colors without such cells.
+func inferSymbols(rows [][]string, pieces bool, isDark func(row, col int) bool) (string, string) {
+	black, white := "", ""
+	for i, row := range rows {
+		for j, c := range row {
+			_, p := cellPiece(c)
+			switch {
+			case pieces && p != 0:
+			case isDark(i, j) && black == "":
+				black = c
+			case !isDark(i, j) && white == "":
+				white = c
+			}
+		}
+	}
+	if black == "" {
+		black = BlackSymbol
+	}
+	if white == "" {
+		white = WhiteSymbol
+	}
+	return black, white
+}
+
+// pieceLetters maps ASCII and unicode piece symbols to FEN letters.
+var pieceLetters = func() map[rune]rune {
+	letters := make(map[rune]rune)
+	for _, pieces := range []PieceSet{ASCIIPieces, UnicodePieces} {
+		for letter, symbol := range pieces {
+			letters[symbol] = letter
+		}
+	}
+	return letters
+}()
+
+// pieceLetter return FEN letter of piece drawn with symbol, zero for other symbols.
+func pieceLetter(symbol rune) rune {
+	return pieceLetters[symbol]
+}
+
+// setPiece places piece with FEN letter on square, allocating pieces of board without them.
+func (br *Board) setPiece(row, col int, letter rune) {
+	if br.Pieces == nil {
+		br.Pieces = make([][]rune, br.Height)
+		for i := range br.Pieces {
+			br.Pieces[i] = make([]rune, br.Width)
+		}
+	}
+	br.Pieces[row][col] = letter
+}
@@ -0,0 +1,157 @@
+package board
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		o         ParseOptions
+		want      *Board
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"default symbols", "* *\n * \n", ParseOptions{},
+			&Board{Height: 2, Width: 3, Squares: [][]string{{"*", " ", "*"}, {" ", "*", " "}}},
+			assert.NoError,
+		},
+		{
+			"user drawn symbols without final newline", "#.\r\n.#", ParseOptions{},
+			&Board{Height: 2, Width: 2, Squares: [][]string{{"#", "."}, {".", "#"}}},
+			assert.NoError,
+		},
+		{
+			"pieces", "k.\n.♔\n", ParseOptions{Pieces: true},
+			&Board{
+				Height: 2, Width: 2,
+				Squares: [][]string{{"k", "."}, {".", "♔"}},
+				Pieces:  [][]rune{{'k', 0}, {0, 'K'}},
+				Colors:  [][]int{{1, 0}, {0, 1}},
+			},
+			assert.NoError,
+		},
+		{
+			"piece letters as symbols", "bn\nnb\n", ParseOptions{},
+			&Board{Height: 2, Width: 2, Squares: [][]string{{"b", "n"}, {"n", "b"}}},
+			assert.NoError,
+		},
+		{
+			"wide cells", "██  \n  ██\n", ParseOptions{CellWidth: 2},
+			&Board{Height: 2, Width: 2, Squares: [][]string{{"██", "  "}, {"  ", "██"}}},
+			assert.NoError,
+		},
+		{
+			"pieces in wide cells", "... k \n###...\n", ParseOptions{Pieces: true, CellWidth: 3},
+			&Board{
+				Height: 2, Width: 2,
+				Squares: [][]string{{"...", "k"}, {"###", "..."}},
+				Pieces:  [][]rune{{0, 'k'}, {0, 0}},
+				Colors:  [][]int{{1, 0}, {0, 1}},
+			},
+			assert.NoError,
+		},
+		{
+			"single square", "#\n", ParseOptions{},
+			&Board{Height: 1, Width: 1, Squares: [][]string{{"#"}}},
+			assert.NoError,
+		},
+		{
+			"any symbols", "k@\n.#\n", ParseOptions{Pieces: true, AnySymbols: true},
+			&Board{
+				Height: 2, Width: 2,
+				Squares: [][]string{{"k", "@"}, {".", "#"}},
+				Pieces:  [][]rune{{'k', 0}, {0, 0}},
+				Colors:  [][]int{{1, 0}, {0, 1}},
+			},
+			assert.NoError,
+		},
+		{"empty text", "", ParseOptions{}, nil, assert.Error},
+		{"empty line", "* \n\n* \n", ParseOptions{}, nil, assert.Error},
+		{"different width", "* *\n *\n", ParseOptions{}, nil, assert.Error},
+		{"broken checker", "* *\n** \n", ParseOptions{}, nil, assert.Error},
+		{"third symbol", "#.\n.o\n", ParseOptions{}, nil, assert.Error},
+		{"any symbols with wrong width", "#.\n.\n", ParseOptions{AnySymbols: true}, nil, assert.Error},
+		{"pieces without option", "k.\n.♔\n", ParseOptions{}, nil, assert.Error},
+		{"partial cell", "██ \n", ParseOptions{CellWidth: 2}, nil, assert.Error},
+		{"negative cell width", "#\n", ParseOptions{CellWidth: -1}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.text), tt.o)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_errorLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr string
+	}{
+		{"empty line", "* \n\n", "line 2 is empty:malformed text board"},
+		{"different width", "* *\n * \n* \n", "line 3 width 2, want 3:malformed text board"},
+		{"broken checker", "* *\n * \n*** \n", "line 3 width 4, want 3:malformed text board"},
+		{"wrong symbol", "* *\n * \n*.*\n", "line 3 column 2 symbol \".\", want \" \":malformed text board"},
+		{"empty text", "", "no lines:malformed text board"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.text), ParseOptions{})
+			assert.True(t, errors.Is(err, ErrText))
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParse_readError(t *testing.T) {
+	_, err := Parse(iotest.ErrReader(errors.New("broken")), ParseOptions{})
+	assert.EqualError(t, err, "reading text board line 1:broken")
+}
+
+func TestParse_roundTrip(t *testing.T) {
+	plain, err := NewBoard(5, 7, "*", " ")
+	assert.NoError(t, err)
+	symbols, err := NewBoard(4, 3, "#", ".")
+	assert.NoError(t, err)
+	ascii, err := FromFEN("rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR", "*", " ", ASCIIPieces)
+	assert.NoError(t, err)
+	unicode, err := FromFEN("k7/8/8/8/8/8/8/7K", "#", ".", UnicodePieces)
+	assert.NoError(t, err)
+
+	for _, br := range []*Board{plain, symbols, ascii, unicode} {
+		w := &bytes.Buffer{}
+		assert.NoError(t, br.Write(w))
+		got, err := Parse(bytes.NewReader(w.Bytes()), ParseOptions{Pieces: true})
+		assert.NoError(t, err)
+		assert.Equal(t, br, got)
+		assert.Equal(t, br.FEN(), got.FEN())
+	}
+}
+
+func TestParse_roundTripCells(t *testing.T) {
+	blocks, err := NewBoard(3, 4, "██", "  ")
+	assert.NoError(t, err)
+	w := &bytes.Buffer{}
+	assert.NoError(t, blocks.Write(w))
+	got, err := Parse(bytes.NewReader(w.Bytes()), ParseOptions{CellWidth: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, blocks, got)
+
+	pieces, err := FromFEN("k1/1Q", "#", ".", UnicodePieces)
+	assert.NoError(t, err)
+	w.Reset()
+	assert.NoError(t, pieces.WriteText(w, TextOptions{CellWidth: 3}))
+	got, err = Parse(bytes.NewReader(w.Bytes()), ParseOptions{Pieces: true, CellWidth: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, pieces.FEN(), got.FEN())
+}
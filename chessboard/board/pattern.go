@@ -0,0 +1,155 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrPattern indicates that a value is not a name of pattern with optional block size.
+	ErrPattern = errors.New("pattern should be one of checker, stripes, vstripes, diagonal, rings with optional :<size>")
+	// ErrSymbols indicates that a board is created without symbols.
+	ErrSymbols = errors.New("symbols should not be empty")
+)
+
+// Pattern return color number of square at row and col of board with height and width,
+// squares are drawn with symbols of color numbers modulo number of symbols.
+type Pattern func(height, width, row, col int) int
+
+// Checker return pattern alternating colors of size x size square blocks.
+func Checker(size int) Pattern {
+	size = blockSize(size)
+	return func(_, _, row, col int) int {
+		return row/size + col/size
+	}
+}
+
+// Stripes return pattern of horizontal stripes with size rows.
+func Stripes(size int) Pattern {
+	size = blockSize(size)
+	return func(_, _, row, _ int) int {
+		return row / size
+	}
+}
+
+// VerticalStripes return pattern of vertical stripes with size columns.
+func VerticalStripes(size int) Pattern {
+	size = blockSize(size)
+	return func(_, _, _, col int) int {
+		return col / size
+	}
+}
+
+// DiagonalStripes return pattern of stripes going from bottom left to top right with size squares.
+func DiagonalStripes(size int) Pattern {
+	size = blockSize(size)
+	return func(_, _, row, col int) int {
+		return (row + col) / size
+	}
+}
+
+// Rings return pattern of concentric rings with size squares, outer ring has color zero.
+func Rings(size int) Pattern {
+	size = blockSize(size)
+	return func(height, width, row, col int) int {
+		d := row
+		for _, e := range []int{col, height - 1 - row, width - 1 - col} {
+			if e < d {
+				d = e
+			}
+		}
+		return d / size
+	}
+}
+
+// Offset return pattern with color numbers increased by n,
+// offset of one makes top left square of checker light.
+func Offset(p Pattern, n int) Pattern {
+	return func(height, width, row, col int) int {
+		return p(height, width, row, col) + n
+	}
+}
+
+// blockSize treats sizes smaller than one as one.
+func blockSize(size int) int {
+	if size < 1 {
+		return 1
+	}
+	return size
+}
+
+// patterns maps pattern names to their constructors.
+var patterns = map[string]func(size int) Pattern{
+	"checker":  Checker,
+	"stripes":  Stripes,
+	"vstripes": VerticalStripes,
+	"diagonal": DiagonalStripes,
+	"rings":    Rings,
+}
+
+// PatternNames return sorted names accepted by ParsePattern.
+func PatternNames() []string {
+	names := make([]string, 0, len(patterns))
+	for name := range patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParsePattern return pattern written as name or name:size like checker:2.
+func ParsePattern(s string) (Pattern, error) {
+	name, size := s, 1
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		n, err := strconv.Atoi(s[i+1:])
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("parse pattern %q size:%w", s, ErrPattern)
+		}
+		name, size = s[:i], n
+	}
+	newPattern, ok := patterns[name]
+	if !ok {
+		return nil, fmt.Errorf("parse pattern %q:%w", s, ErrPattern)
+	}
+	return newPattern(size), nil
+}
+
+// NewPatternBoard creates board with height and width sizes and squares colored by pattern
+// and drawn with symbols of colors.
+func NewPatternBoard(height, width int, p Pattern, symbols []string) (*Board, error) {
+	if height <= 0 || width <= 0 {
+		return nil, ErrSize
+	}
+	if len(symbols) == 0 {
+		return nil, ErrSymbols
+	}
+	squares := make([][]string, height)
+	colors := make([][]int, height)
+	for i := range squares {
+		squares[i] = make([]string, width)
+		colors[i] = make([]int, width)
+		for j := range squares[i] {
+			c := p(height, width, i, j) % len(symbols)
+			squares[i][j], colors[i][j] = symbols[c], c
+		}
+	}
+	return &Board{Height: height, Width: width, Squares: squares, Colors: colors}, nil
+}
+
+// WritePatternStream write board with height and width sizes and squares colored by pattern
+// to writer row by row like WriteStream.
+func WritePatternStream(w io.Writer, height, width int, p Pattern, symbols []string, o TextOptions) error {
+	if height <= 0 || width <= 0 {
+		return ErrSize
+	}
+	if len(symbols) == 0 {
+		return ErrSymbols
+	}
+	return writeText(w, height, width, func(row, col int) (string, bool) {
+		return symbols[p(height, width, row, col)%len(symbols)], false
+	}, o)
+}
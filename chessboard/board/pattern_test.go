@@ -0,0 +1,121 @@
+package board
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPatternBoard(t *testing.T) {
+	type args struct {
+		height  int
+		width   int
+		p       Pattern
+		symbols []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"checker", args{2, 3, Checker(1), []string{"*", " "}}, "* *\n * \n", assert.NoError},
+		{"checker blocks", args{4, 4, Checker(2), []string{"#", "."}}, "##..\n##..\n..##\n..##\n", assert.NoError},
+		{"three colors checker", args{2, 4, Checker(1), []string{"a", "b", "c"}}, "abca\nbcab\n", assert.NoError},
+		{"stripes", args{3, 3, Stripes(1), []string{"#", "."}}, "###\n...\n###\n", assert.NoError},
+		{"wide stripes", args{4, 2, Stripes(2), []string{"#", "."}}, "##\n##\n..\n..\n", assert.NoError},
+		{"vertical stripes", args{2, 4, VerticalStripes(1), []string{"a", "b", "c"}}, "abca\nabca\n", assert.NoError},
+		{"diagonal stripes", args{3, 4, DiagonalStripes(1), []string{"a", "b", "c"}}, "abca\nbcab\ncabc\n", assert.NoError},
+		{
+			"rings", args{5, 6, Rings(1), []string{"#", ".", "o"}},
+			"######\n#....#\n#.oo.#\n#....#\n######\n", assert.NoError,
+		},
+		{"string symbols", args{1, 3, Stripes(1), []string{"[]"}}, "[][][]\n", assert.NoError},
+		{"light start checker", args{2, 3, Offset(Checker(1), 1), []string{"#", "."}}, ".#.\n#.#\n", assert.NoError},
+		{"zero block size", args{1, 2, Checker(0), []string{"#", "."}}, "#.\n", assert.NoError},
+		{"no symbols", args{1, 2, Checker(1), nil}, "", assert.Error},
+		{"invalid size", args{0, 2, Checker(1), []string{"#"}}, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPatternBoard(tt.args.height, tt.args.width, tt.args.p, tt.args.symbols)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestBoard_Color(t *testing.T) {
+	checker, err := NewBoard(2, 2, "*", " ")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{0, 1}, {1, 0}}, colors(checker))
+
+	rings, err := NewPatternBoard(3, 3, Rings(1), []string{"a", "b", "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{0, 0, 0}, {0, 1, 0}, {0, 0, 0}}, colors(rings))
+	assert.True(t, rings.IsDark(0, 1))
+	assert.False(t, rings.IsDark(1, 1))
+}
+
+func TestParsePattern(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"checker", "checker", "#.#.\n.#.#\n", assert.NoError},
+		{"checker with size", "checker:2", "##..\n##..\n", assert.NoError},
+		{"vertical stripes", "vstripes:3", "###.\n###.\n", assert.NoError},
+		{"unknown name", "waves", "", assert.Error},
+		{"zero size", "stripes:0", "", assert.Error},
+		{"invalid size", "rings:x", "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePattern(tt.s)
+			tt.assertion(t, err)
+			if err != nil {
+				return
+			}
+			br, err := NewPatternBoard(2, 4, p, []string{"#", "."})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, br.String())
+		})
+	}
+}
+
+func TestPatternNames(t *testing.T) {
+	assert.Equal(t, []string{"checker", "diagonal", "rings", "stripes", "vstripes"}, PatternNames())
+}
+
+func TestWritePatternStream(t *testing.T) {
+	symbols := []string{"#", ".", "o"}
+	br, err := NewPatternBoard(7, 9, Rings(2), symbols)
+	assert.NoError(t, err)
+	want := &bytes.Buffer{}
+	assert.NoError(t, br.WriteText(want, TextOptions{Frame: true, CellWidth: 2}))
+
+	w := &bytes.Buffer{}
+	assert.NoError(t, WritePatternStream(w, 7, 9, Rings(2), symbols, TextOptions{Frame: true, CellWidth: 2}))
+	assert.Equal(t, want.String(), w.String())
+
+	assert.Error(t, WritePatternStream(w, 1, 1, Rings(2), nil, TextOptions{}))
+	assert.Error(t, WritePatternStream(w, 1, 0, Rings(2), symbols, TextOptions{}))
+}
+
+func colors(br *Board) [][]int {
+	c := make([][]int, br.Height)
+	for i := range c {
+		c[i] = make([]int, br.Width)
+		for j := range c[i] {
+			c[i][j] = br.Color(i, j)
+		}
+	}
+	return c
+}
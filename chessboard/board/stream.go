@@ -0,0 +1,10 @@
+package board
+
+import "io"
+
+// WriteStream write board with height and width sizes and black and white symbols
+// to writer row by row, squares are computed from coordinates
+// so memory used does not depend on board sizes.
+func WriteStream(w io.Writer, height, width int, blackSymbol, whiteSymbol string, o TextOptions) error {
+	return WritePatternStream(w, height, width, Checker(1), []string{blackSymbol, whiteSymbol}, o)
+}
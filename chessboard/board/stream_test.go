@@ -0,0 +1,82 @@
+package board
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteStream(t *testing.T) {
+	type args struct {
+		height int
+		width  int
+		o      TextOptions
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"valid sizes", args{height: 3, width: 4}, "* * \n * *\n* * \n", assert.NoError},
+		{"labels", args{height: 2, width: 2, o: TextOptions{Labels: true}}, "2 * \n1  *\n  ab\n", assert.NoError},
+		{"negative height", args{height: -1, width: 2}, "", assert.Error},
+		{"zero width", args{height: 1, width: 0}, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, WriteStream(w, tt.args.height, tt.args.width, "*", " ", tt.args.o))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func TestWriteStream_matchesBoard(t *testing.T) {
+	o := TextOptions{Frame: true, Labels: true}
+	for _, size := range [][2]int{{1, 1}, {8, 8}, {7, 30}, {12, 5}} {
+		t.Run(fmt.Sprintf("%dx%d", size[0], size[1]), func(t *testing.T) {
+			br, err := NewBoard(size[0], size[1], "#", ".")
+			assert.NoError(t, err)
+			want, got := &bytes.Buffer{}, &bytes.Buffer{}
+			assert.NoError(t, br.WriteText(want, o))
+			assert.NoError(t, WriteStream(got, size[0], size[1], "#", ".", o))
+			assert.Equal(t, want.String(), got.String())
+		})
+	}
+}
+
+func BenchmarkBoard_Write(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(size * (size + 1)))
+			for i := 0; i < b.N; i++ {
+				br, err := NewBoard(size, size, BlackSymbol, WhiteSymbol)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if err := br.Write(io.Discard); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkWriteStream(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(size * (size + 1)))
+			for i := 0; i < b.N; i++ {
+				if err := WriteStream(io.Discard, size, size, BlackSymbol, WhiteSymbol, TextOptions{}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package board
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextOptions represent board text rendering options.
+type TextOptions struct {
+	// Frame draws box around squares.
+	Frame bool
+	// Labels draws rank numbers on the left and file letters below squares.
+	Labels bool
+	// CellHeight is a number of lines every square is written in, zero means one line.
+	CellHeight int
+	// CellWidth is a number of characters every square is filled with
+	// by repeating its symbol, zero writes symbol as is.
+	CellWidth int
+}
+
+// squareFunc return symbol of square and whether it is a piece.
+type squareFunc func(row, col int) (symbol string, piece bool)
+
+// textWriter writes squares as cell blocks.
+type textWriter struct {
+	*bufio.Writer
+	o      TextOptions
+	square squareFunc
+	lines  int
+	fills  map[string]string
+}
+
+// writeText writes height x width squares returned by square function row by row.
+func writeText(w io.Writer, height, width int, square squareFunc, o TextOptions) error {
+	if o.CellHeight < 0 || o.CellWidth < 0 {
+		return fmt.Errorf("text options:%w", ErrSize)
+	}
+	tw := &textWriter{
+		Writer: bufio.NewWriterSize(w, 64*1024),
+		o:      o,
+		square: square,
+		lines:  max(1, o.CellHeight),
+		fills:  make(map[string]string),
+	}
+	indent := ""
+	if o.Labels {
+		indent = strings.Repeat(" ", len(rankLabel(height, 0))+1)
+	}
+	frame := ""
+	if o.Frame && height > 0 {
+		columns := 0
+		for j := 0; j < width; j++ {
+			columns += tw.columnWidth(j)
+		}
+		frame = strings.Repeat("─", columns)
+		fmt.Fprintf(tw, "%s┌%s┐\n", indent, frame)
+	}
+	for i := 0; i < height; i++ {
+		for line := 0; line < tw.lines; line++ {
+			tw.writeLine(height, width, i, line, indent)
+		}
+	}
+	if o.Frame && height > 0 {
+		fmt.Fprintf(tw, "%s└%s┘\n", indent, frame)
+		indent += " "
+	}
+	if o.Labels && height > 0 {
+		tw.writeFileLabels(width, indent)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("writing board:%w", err)
+	}
+	return nil
+}
+
+func (tw *textWriter) writeLine(height, width, row, line int, indent string) {
+	if tw.o.Labels {
+		if line == tw.middle() {
+			fmt.Fprintf(tw, "%*s ", len(indent)-1, rankLabel(height, row))
+		} else {
+			tw.WriteString(indent)
+		}
+	}
+	if tw.o.Frame {
+		tw.WriteRune('│')
+	}
+	for j := 0; j < width; j++ {
+		symbol, piece := tw.square(row, j)
+		tw.WriteString(tw.cell(symbol, piece, line))
+	}
+	if tw.o.Frame {
+		tw.WriteRune('│')
+	}
+	tw.WriteRune('\n')
+}
+
+func (tw *textWriter) middle() int {
+	return (tw.lines - 1) / 2
+}
+
+// cell return line of square cell, pieces are centered on the middle line
+// and other symbols are repeated to fill the cell width.
+func (tw *textWriter) cell(symbol string, piece bool, line int) string {
+	if piece {
+		n := utf8.RuneCountInString(symbol)
+		width := max(n, tw.o.CellWidth)
+		if line != tw.middle() {
+			return strings.Repeat(" ", width)
+		}
+		left := (width - n) / 2
+		return strings.Repeat(" ", left) + symbol + strings.Repeat(" ", width-n-left)
+	}
+	if tw.o.CellWidth == 0 {
+		return symbol
+	}
+	fill, ok := tw.fills[symbol]
+	if !ok {
+		runes := []rune(symbol)
+		if len(runes) == 0 {
+			runes = []rune{' '}
+		}
+		b := make([]rune, tw.o.CellWidth)
+		for k := range b {
+			b[k] = runes[k%len(runes)]
+		}
+		fill = string(b)
+		tw.fills[symbol] = fill
+	}
+	return fill
+}
+
+// columnWidth return number of characters in column of first row.
+func (tw *textWriter) columnWidth(col int) int {
+	symbol, piece := tw.square(0, col)
+	return utf8.RuneCountInString(tw.cell(symbol, piece, tw.middle()))
+}
+
+// writeFileLabels writes file labels centered below columns,
+// labels wider than column are written top down in several lines.
+func (tw *textWriter) writeFileLabels(width int, indent string) {
+	lines := 1
+	for j := 0; j < width; j++ {
+		if l := fileLabel(j); len(l) > tw.columnWidth(j) {
+			lines = max(lines, len(l))
+		}
+	}
+	for line := 0; line < lines; line++ {
+		tw.WriteString(indent)
+		for j := 0; j < width; j++ {
+			l, cw := fileLabel(j), tw.columnWidth(j)
+			text := ""
+			switch k := line - (lines - len(l)); {
+			case len(l) <= cw && line == lines-1:
+				text = l
+			case len(l) > cw && k >= 0:
+				text = l[k : k+1]
+			}
+			left := max(0, (cw-len(text))/2)
+			tw.WriteString(strings.Repeat(" ", left) + text + strings.Repeat(" ", max(0, cw-len(text)-left)))
+		}
+		tw.WriteRune('\n')
+	}
+}
@@ -0,0 +1,84 @@
+package board
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_WriteText_cells(t *testing.T) {
+	small, err := NewBoard(2, 2, "#", ".")
+	assert.NoError(t, err)
+	blocks, err := NewBoard(1, 2, "██", "  ")
+	assert.NoError(t, err)
+	pieces, err := FromFEN("1k/2", "#", ".", ASCIIPieces)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		br        *Board
+		o         TextOptions
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"2x4 cells", small, TextOptions{CellHeight: 2, CellWidth: 4},
+			"####....\n####....\n....####\n....####\n", assert.NoError,
+		},
+		{
+			"string symbols", blocks, TextOptions{},
+			"██  \n", assert.NoError,
+		},
+		{
+			"string symbols filling cell", newBoardOrNil(1, 2, "[]", "()"), TextOptions{CellWidth: 3},
+			"[][()(\n", assert.NoError,
+		},
+		{
+			"piece centered in cell", pieces, TextOptions{CellHeight: 3, CellWidth: 3},
+			"...   \n... k \n...   \n###...\n###...\n###...\n", assert.NoError,
+		},
+		{
+			"frame and labels", small, TextOptions{Frame: true, Labels: true, CellHeight: 2, CellWidth: 3},
+			"  ┌──────┐\n" +
+				"2 │###...│\n" +
+				"  │###...│\n" +
+				"1 │...###│\n" +
+				"  │...###│\n" +
+				"  └──────┘\n" +
+				"    a  b \n",
+			assert.NoError,
+		},
+		{
+			"labels of string symbols", blocks, TextOptions{Labels: true},
+			"1 ██  \n  a b \n", assert.NoError,
+		},
+		{
+			"negative cell", small, TextOptions{CellWidth: -1},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, tt.br.WriteText(w, tt.o))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func TestWriteStream_cells(t *testing.T) {
+	w := &bytes.Buffer{}
+	assert.NoError(t, WriteStream(w, 2, 3, "#", ".", TextOptions{CellHeight: 2, CellWidth: 2, Labels: true}))
+	assert.Equal(t, ""+
+		"2 ##..##\n"+
+		"  ##..##\n"+
+		"1 ..##..\n"+
+		"  ..##..\n"+
+		"  a b c \n", w.String())
+}
+
+func newBoardOrNil(height, width int, blackSymbol, whiteSymbol string) *Board {
+	br, _ := NewBoard(height, width, blackSymbol, whiteSymbol)
+	return br
+}
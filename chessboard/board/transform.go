@@ -0,0 +1,83 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrRotation indicates that a rotation angle is not a multiple of 90 degrees.
+	ErrRotation = errors.New("rotation should be a multiple of 90 degrees")
+)
+
+// Rotate return board rotated clockwise by degrees multiple of 90, negative degrees rotate counterclockwise.
+func (br *Board) Rotate(degrees int) (*Board, error) {
+	if degrees%90 != 0 {
+		return nil, fmt.Errorf("rotate %d:%w", degrees, ErrRotation)
+	}
+	h, w := br.Height, br.Width
+	switch (degrees%360 + 360) % 360 {
+	case 90:
+		return br.transform(w, h, func(row, col int) (int, int) { return h - 1 - col, row }), nil
+	case 180:
+		return br.transform(h, w, func(row, col int) (int, int) { return h - 1 - row, w - 1 - col }), nil
+	case 270:
+		return br.transform(w, h, func(row, col int) (int, int) { return col, w - 1 - row }), nil
+	}
+	return br.transform(h, w, func(row, col int) (int, int) { return row, col }), nil
+}
+
+// MirrorHorizontal return board mirrored left to right.
+func (br *Board) MirrorHorizontal() *Board {
+	return br.transform(br.Height, br.Width, func(row, col int) (int, int) { return row, br.Width - 1 - col })
+}
+
+// MirrorVertical return board mirrored top to bottom.
+func (br *Board) MirrorVertical() *Board {
+	return br.transform(br.Height, br.Width, func(row, col int) (int, int) { return br.Height - 1 - row, col })
+}
+
+// Transpose return board mirrored over its top left to bottom right diagonal.
+func (br *Board) Transpose() *Board {
+	return br.transform(br.Width, br.Height, func(row, col int) (int, int) { return col, row })
+}
+
+// Crop return height x width window of board with top left square at row and col.
+func (br *Board) Crop(row, col, height, width int) (*Board, error) {
+	if height <= 0 || width <= 0 || row < 0 || col < 0 || row+height > br.Height || col+width > br.Width {
+		return nil, fmt.Errorf("crop %dx%d at %d,%d of %dx%d board:%w", height, width, row, col, br.Height, br.Width, ErrSize)
+	}
+	return br.transform(height, width, func(r, c int) (int, int) { return row + r, col + c }), nil
+}
+
+// transform return new board with height and width which squares are copied
+// from squares of board returned by source. Square colors are kept, they are
+// stored in Colors when they do not form checker of new board.
+func (br *Board) transform(height, width int, source func(row, col int) (int, int)) *Board {
+	t := &Board{Height: height, Width: width, Squares: make([][]string, height)}
+	if br.Pieces != nil {
+		t.Pieces = make([][]rune, height)
+	}
+	colors := make([][]int, height)
+	checker := true
+	for i := 0; i < height; i++ {
+		t.Squares[i] = make([]string, width)
+		colors[i] = make([]int, width)
+		if t.Pieces != nil {
+			t.Pieces[i] = make([]rune, width)
+		}
+		for j := 0; j < width; j++ {
+			r, c := source(i, j)
+			t.Squares[i][j] = br.Squares[r][c]
+			if t.Pieces != nil {
+				t.Pieces[i][j] = br.Pieces[r][c]
+			}
+			colors[i][j] = br.Color(r, c)
+			checker = checker && colors[i][j] == t.Color(i, j)
+		}
+	}
+	if !checker {
+		t.Colors = colors
+	}
+	return t
+}
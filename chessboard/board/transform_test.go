@@ -0,0 +1,121 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoard_Rotate(t *testing.T) {
+	br := &Board{Height: 2, Width: 3, Squares: [][]string{{"a", "b", "c"}, {"d", "e", "f"}}}
+	tests := []struct {
+		name      string
+		degrees   int
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"zero", 0, "abc\ndef\n", assert.NoError},
+		{"clockwise", 90, "da\neb\nfc\n", assert.NoError},
+		{"upside down", 180, "fed\ncba\n", assert.NoError},
+		{"counterclockwise", 270, "cf\nbe\nad\n", assert.NoError},
+		{"negative", -90, "cf\nbe\nad\n", assert.NoError},
+		{"full turns", 450, "da\neb\nfc\n", assert.NoError},
+		{"not right angle", 45, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := br.Rotate(tt.degrees)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+	assert.Equal(t, "abc\ndef\n", br.String())
+}
+
+func TestBoard_Rotate_pieces(t *testing.T) {
+	br, err := FromFEN("rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR", "*", " ", ASCIIPieces)
+	assert.NoError(t, err)
+	got, err := br.Rotate(180)
+	assert.NoError(t, err)
+	want, err := FromFEN("RNBKQBNR/PPP1PPPP/8/3P4/8/8/pppppppp/rnbkqbnr", "*", " ", ASCIIPieces)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestBoard_Rotate_colors(t *testing.T) {
+	br, err := NewBoard(2, 3, "*", " ")
+	assert.NoError(t, err)
+	got, err := br.Rotate(90)
+	assert.NoError(t, err)
+	assert.Equal(t, " *\n* \n *\n", got.String())
+	assert.Equal(t, [][]int{{1, 0}, {0, 1}, {1, 0}}, got.Colors)
+	assert.False(t, got.IsDark(0, 0))
+}
+
+func TestBoard_Mirror(t *testing.T) {
+	br := &Board{
+		Height: 2, Width: 3,
+		Squares: [][]string{{"a", "b", "c"}, {"d", "e", "f"}},
+		Pieces:  [][]rune{{'K', 0, 0}, {0, 0, 'k'}},
+	}
+	h := br.MirrorHorizontal()
+	assert.Equal(t, "cba\nfed\n", h.String())
+	assert.Equal(t, [][]rune{{0, 0, 'K'}, {'k', 0, 0}}, h.Pieces)
+	assert.Nil(t, h.Colors)
+
+	v := br.MirrorVertical()
+	assert.Equal(t, "def\nabc\n", v.String())
+	assert.Equal(t, [][]int{{1, 0, 1}, {0, 1, 0}}, v.Colors)
+
+	tr := br.Transpose()
+	assert.Equal(t, "ad\nbe\ncf\n", tr.String())
+	assert.Equal(t, [][]rune{{'K', 0}, {0, 0}, {0, 'k'}}, tr.Pieces)
+	assert.Nil(t, tr.Colors)
+	assert.Equal(t, "abc\ndef\n", br.String())
+}
+
+func TestBoard_Crop(t *testing.T) {
+	br, err := NewPatternBoard(4, 4, Rings(1), []string{"#", "."})
+	assert.NoError(t, err)
+	tests := []struct {
+		name      string
+		row, col  int
+		h, w      int
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"whole board", 0, 0, 4, 4, "####\n#..#\n#..#\n####\n", assert.NoError},
+		{"center", 1, 1, 2, 2, "..\n..\n", assert.NoError},
+		{"corner", 2, 1, 2, 3, "..#\n###\n", assert.NoError},
+		{"outside board", 3, 3, 2, 2, "", assert.Error},
+		{"negative position", -1, 0, 2, 2, "", assert.Error},
+		{"empty window", 0, 0, 0, 2, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := br.Crop(tt.row, tt.col, tt.h, tt.w)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestBoard_transformChain(t *testing.T) {
+	br, err := NewPatternBoard(3, 5, DiagonalStripes(1), []string{"a", "b", "c"})
+	assert.NoError(t, err)
+	r, err := br.Rotate(90)
+	assert.NoError(t, err)
+	back, err := r.Rotate(-90)
+	assert.NoError(t, err)
+	assert.Equal(t, br, back)
+	assert.Equal(t, br, br.Transpose().Transpose())
+	assert.Equal(t, br, br.MirrorHorizontal().MirrorHorizontal())
+}
@@ -0,0 +1,314 @@
+package chess
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrIllegalMove indicates that a move is not legal in position.
+	ErrIllegalMove = errors.New("illegal move")
+)
+
+// Move represent piece move from square to square.
+type Move struct {
+	From      Square
+	To        Square
+	Promotion PieceType
+}
+
+// String return move in coordinate notation like e2e4 or e7e8q.
+func (m Move) String() string {
+	s := m.From.String() + m.To.String()
+	if m.Promotion != NoPieceType {
+		s += string(pieceLetters[m.Promotion])
+	}
+	return s
+}
+
+// ParseMove parse move in coordinate notation like e2e4 or e7e8q.
+func ParseMove(s string) (Move, error) {
+	m := Move{}
+	if len(s) != 4 && len(s) != 5 {
+		return m, fmt.Errorf("parse move %q:%w", s, ErrIllegalMove)
+	}
+	var err error
+	if m.From, err = ParseSquare(s[:2]); err != nil {
+		return m, fmt.Errorf("parse move %q:%w", s, err)
+	}
+	if m.To, err = ParseSquare(s[2:4]); err != nil {
+		return m, fmt.Errorf("parse move %q:%w", s, err)
+	}
+	if len(s) == 5 {
+		pc, ok := PieceFromLetter(rune(strings.ToLower(s)[4]))
+		if !ok || pc.Type == Pawn || pc.Type == King {
+			return m, fmt.Errorf("parse move %q promotion:%w", s, ErrIllegalMove)
+		}
+		m.Promotion = pc.Type
+	}
+	return m, nil
+}
+
+type direction struct {
+	file, rank int
+}
+
+var (
+	knightDirections = []direction{{1, 2}, {2, 1}, {2, -1}, {1, -2}, {-1, -2}, {-2, -1}, {-2, 1}, {-1, 2}}
+	bishopDirections = []direction{{1, 1}, {1, -1}, {-1, -1}, {-1, 1}}
+	rookDirections   = []direction{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+	kingDirections   = append(append([]direction{}, bishopDirections...), rookDirections...)
+
+	promotions = []PieceType{Queen, Rook, Bishop, Knight}
+)
+
+// IsAttacked indicate if square is attacked by any piece with color.
+func (p *Position) IsAttacked(sq Square, by Color) bool {
+	if sq == NoSquare {
+		return false
+	}
+	f, r := sq.File(), sq.Rank()
+	pawnRank := r - 1
+	if by == Black {
+		pawnRank = r + 1
+	}
+	for _, df := range []int{-1, 1} {
+		if onBoard(f+df, pawnRank) && p.Squares[NewSquare(f+df, pawnRank)] == (Piece{Type: Pawn, Color: by}) {
+			return true
+		}
+	}
+	if p.attackedByStep(f, r, knightDirections, Piece{Type: Knight, Color: by}) ||
+		p.attackedByStep(f, r, kingDirections, Piece{Type: King, Color: by}) {
+		return true
+	}
+	return p.attackedBySlide(f, r, bishopDirections, by, Bishop) ||
+		p.attackedBySlide(f, r, rookDirections, by, Rook)
+}
+
+func (p *Position) attackedByStep(f, r int, dirs []direction, attacker Piece) bool {
+	for _, d := range dirs {
+		if onBoard(f+d.file, r+d.rank) && p.Squares[NewSquare(f+d.file, r+d.rank)] == attacker {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Position) attackedBySlide(f, r int, dirs []direction, by Color, slider PieceType) bool {
+	for _, d := range dirs {
+		for ff, rr := f+d.file, r+d.rank; onBoard(ff, rr); ff, rr = ff+d.file, rr+d.rank {
+			pc := p.Squares[NewSquare(ff, rr)]
+			if pc == NoPiece {
+				continue
+			}
+			if pc.Color == by && (pc.Type == slider || pc.Type == Queen) {
+				return true
+			}
+			break
+		}
+	}
+	return false
+}
+
+// LegalMoves return all legal moves of side to move.
+func (p *Position) LegalMoves() []Move {
+	moves := p.pseudoLegalMoves()
+	legal := moves[:0]
+	for _, m := range moves {
+		if !p.Play(m).InCheck(p.Turn) {
+			legal = append(legal, m)
+		}
+	}
+	return legal
+}
+
+// IsLegal indicate if move is legal in position.
+func (p *Position) IsLegal(m Move) bool {
+	for _, lm := range p.LegalMoves() {
+		if lm == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Position) pseudoLegalMoves() []Move {
+	moves := make([]Move, 0, 64)
+	for i, pc := range p.Squares {
+		if pc == NoPiece || pc.Color != p.Turn {
+			continue
+		}
+		sq := Square(i)
+		switch pc.Type {
+		case Pawn:
+			moves = p.pawnMoves(moves, sq)
+		case Knight:
+			moves = p.stepMoves(moves, sq, knightDirections)
+		case Bishop:
+			moves = p.slideMoves(moves, sq, bishopDirections)
+		case Rook:
+			moves = p.slideMoves(moves, sq, rookDirections)
+		case Queen:
+			moves = p.slideMoves(moves, sq, kingDirections)
+		case King:
+			moves = p.stepMoves(moves, sq, kingDirections)
+			moves = p.castlingMoves(moves, sq)
+		}
+	}
+	return moves
+}
+
+func (p *Position) pawnMoves(moves []Move, sq Square) []Move {
+	f, r := sq.File(), sq.Rank()
+	dir, start, last := 1, 1, 7
+	if p.Turn == Black {
+		dir, start, last = -1, 6, 0
+	}
+	add := func(to Square) {
+		if to.Rank() != last {
+			moves = append(moves, Move{From: sq, To: to})
+			return
+		}
+		for _, pt := range promotions {
+			moves = append(moves, Move{From: sq, To: to, Promotion: pt})
+		}
+	}
+
+	if one := NewSquare(f, r+dir); p.Squares[one] == NoPiece {
+		add(one)
+		if two := NewSquare(f, r+2*dir); r == start && p.Squares[two] == NoPiece {
+			add(two)
+		}
+	}
+	for _, df := range []int{-1, 1} {
+		if !onBoard(f+df, r+dir) {
+			continue
+		}
+		to := NewSquare(f+df, r+dir)
+		if pc := p.Squares[to]; (pc != NoPiece && pc.Color != p.Turn) || to == p.EnPassant {
+			add(to)
+		}
+	}
+	return moves
+}
+
+func (p *Position) stepMoves(moves []Move, sq Square, dirs []direction) []Move {
+	f, r := sq.File(), sq.Rank()
+	for _, d := range dirs {
+		if !onBoard(f+d.file, r+d.rank) {
+			continue
+		}
+		to := NewSquare(f+d.file, r+d.rank)
+		if pc := p.Squares[to]; pc == NoPiece || pc.Color != p.Turn {
+			moves = append(moves, Move{From: sq, To: to})
+		}
+	}
+	return moves
+}
+
+func (p *Position) slideMoves(moves []Move, sq Square, dirs []direction) []Move {
+	f, r := sq.File(), sq.Rank()
+	for _, d := range dirs {
+		for ff, rr := f+d.file, r+d.rank; onBoard(ff, rr); ff, rr = ff+d.file, rr+d.rank {
+			to := NewSquare(ff, rr)
+			pc := p.Squares[to]
+			if pc == NoPiece || pc.Color != p.Turn {
+				moves = append(moves, Move{From: sq, To: to})
+			}
+			if pc != NoPiece {
+				break
+			}
+		}
+	}
+	return moves
+}
+
+type castlingRule struct {
+	right      Castling
+	king, rook Square
+	to         Square
+	empty      []Square
+	safe       []Square
+}
+
+var castlingRules = []castlingRule{
+	{WhiteKingSide, 4, 7, 6, []Square{5, 6}, []Square{4, 5, 6}},
+	{WhiteQueenSide, 4, 0, 2, []Square{1, 2, 3}, []Square{4, 3, 2}},
+	{BlackKingSide, 60, 63, 62, []Square{61, 62}, []Square{60, 61, 62}},
+	{BlackQueenSide, 60, 56, 58, []Square{57, 58, 59}, []Square{60, 59, 58}},
+}
+
+func (p *Position) castlingMoves(moves []Move, sq Square) []Move {
+	for _, cr := range castlingRules {
+		if p.Castling&cr.right == 0 || cr.king != sq ||
+			p.Squares[cr.rook] != (Piece{Type: Rook, Color: p.Turn}) ||
+			!p.allEmpty(cr.empty) || p.anyAttacked(cr.safe, p.Turn.Other()) {
+			continue
+		}
+		moves = append(moves, Move{From: sq, To: cr.to})
+	}
+	return moves
+}
+
+func (p *Position) allEmpty(squares []Square) bool {
+	for _, sq := range squares {
+		if p.Squares[sq] != NoPiece {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Position) anyAttacked(squares []Square, by Color) bool {
+	for _, sq := range squares {
+		if p.IsAttacked(sq, by) {
+			return true
+		}
+	}
+	return false
+}
+
+// Play return new position after move, move legality is not checked.
+func (p *Position) Play(m Move) *Position {
+	next := *p
+	pc := p.Squares[m.From]
+	captured := p.Squares[m.To]
+	next.Squares[m.From], next.Squares[m.To] = NoPiece, pc
+	next.EnPassant = NoSquare
+
+	switch pc.Type {
+	case Pawn:
+		if m.To == p.EnPassant && m.From.File() != m.To.File() {
+			next.Squares[NewSquare(m.To.File(), m.From.Rank())] = NoPiece
+		}
+		if d := m.To.Rank() - m.From.Rank(); d == 2 || d == -2 {
+			next.EnPassant = NewSquare(m.From.File(), m.From.Rank()+d/2)
+		}
+		if m.Promotion != NoPieceType {
+			next.Squares[m.To] = Piece{Type: m.Promotion, Color: pc.Color}
+		}
+	case King:
+		for _, cr := range castlingRules {
+			if cr.king == m.From && cr.to == m.To {
+				next.Squares[cr.rook] = NoPiece
+				next.Squares[(cr.king+cr.to)/2] = Piece{Type: Rook, Color: pc.Color}
+			}
+		}
+	}
+	for _, cr := range castlingRules {
+		if m.From == cr.king || m.From == cr.rook || m.To == cr.rook {
+			next.Castling &^= cr.right
+		}
+	}
+
+	next.HalfMoveClock++
+	if pc.Type == Pawn || captured != NoPiece {
+		next.HalfMoveClock = 0
+	}
+	if p.Turn == Black {
+		next.FullMoveNumber++
+	}
+	next.Turn = p.Turn.Other()
+	return &next
+}
@@ -0,0 +1,150 @@
+package chess
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      Move
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"simple move", "e2e4", Move{From: 12, To: 28}, assert.NoError},
+		{"promotion", "a7a8q", Move{From: 48, To: 56, Promotion: Queen}, assert.NoError},
+		{"uppercase promotion", "a7a8N", Move{From: 48, To: 56, Promotion: Knight}, assert.NoError},
+		{"king promotion", "a7a8k", Move{From: 48, To: 56}, assert.Error},
+		{"invalid square", "e2e9", Move{From: 12, To: NoSquare}, assert.Error},
+		{"too short", "e2", Move{}, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMove(tt.s)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMove_String(t *testing.T) {
+	assert.Equal(t, "e2e4", Move{From: 12, To: 28}.String())
+	assert.Equal(t, "b2a1r", Move{From: 9, To: 0, Promotion: Rook}.String())
+}
+
+func TestPosition_LegalMoves(t *testing.T) {
+	tests := []struct {
+		name string
+		fen  string
+		want []string
+	}{
+		{
+			"pinned knight can't move",
+			"4k3/4r3/8/8/8/8/4N3/4K3 w - -",
+			[]string{"e1d1", "e1d2", "e1f1", "e1f2"},
+		},
+		{
+			"en passant",
+			"4k3/8/8/3pP3/8/8/8/4K3 w - d6",
+			[]string{"e1d1", "e1d2", "e1e2", "e1f1", "e1f2", "e5d6", "e5e6"},
+		},
+		{
+			"en passant exposes king",
+			"8/8/8/K2pP2r/8/8/8/7k w - d6",
+			[]string{"a5a4", "a5a6", "a5b4", "a5b5", "a5b6", "e5e6"},
+		},
+		{
+			"castling through check",
+			"4k3/8/8/8/8/8/8/R3K2R w KQ - 0 1",
+			[]string{
+				"a1a2", "a1a3", "a1a4", "a1a5", "a1a6", "a1a7", "a1a8", "a1b1", "a1c1", "a1d1",
+				"e1c1", "e1d1", "e1d2", "e1e2", "e1f1", "e1f2", "e1g1",
+				"h1f1", "h1g1", "h1h2", "h1h3", "h1h4", "h1h5", "h1h6", "h1h7", "h1h8",
+			},
+		},
+		{
+			"castling blocked by attack",
+			"4kr2/8/8/8/8/8/8/R3K2R w KQ - 0 1",
+			[]string{
+				"a1a2", "a1a3", "a1a4", "a1a5", "a1a6", "a1a7", "a1a8", "a1b1", "a1c1", "a1d1",
+				"e1c1", "e1d1", "e1d2", "e1e2",
+				"h1f1", "h1g1", "h1h2", "h1h3", "h1h4", "h1h5", "h1h6", "h1h7", "h1h8",
+			},
+		},
+		{
+			"promotion",
+			"8/P6k/8/8/8/8/8/K7 w - -",
+			[]string{"a1a2", "a1b1", "a1b2", "a7a8b", "a7a8n", "a7a8q", "a7a8r"},
+		},
+		{
+			"checkmate",
+			"R5k1/5ppp/8/8/8/8/8/6K1 b - -",
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			got := []string{}
+			for _, m := range p.LegalMoves() {
+				got = append(got, m.String())
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPosition_Play(t *testing.T) {
+	tests := []struct {
+		name string
+		fen  string
+		move string
+		want string
+	}{
+		{
+			"double pawn push",
+			StartFEN, "e2e4",
+			"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1",
+		},
+		{
+			"en passant capture",
+			"4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", "e5d6",
+			"4k3/8/3P4/8/8/8/8/4K3 b - - 0 1",
+		},
+		{
+			"white king side castling",
+			"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 5 10", "e1g1",
+			"r3k2r/8/8/8/8/8/8/R4RK1 b kq - 6 10",
+		},
+		{
+			"black queen side castling",
+			"r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 5 10", "e8c8",
+			"2kr3r/8/8/8/8/8/8/R3K2R w KQ - 6 11",
+		},
+		{
+			"rook capture removes castling",
+			"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 5 10", "a1a8",
+			"R3k2r/8/8/8/8/8/8/4K2R b Kk - 0 10",
+		},
+		{
+			"promotion",
+			"8/P6k/8/8/8/8/8/K7 w - - 0 1", "a7a8n",
+			"N7/7k/8/8/8/8/8/K7 b - - 0 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			m, err := ParseMove(tt.move)
+			assert.NoError(t, err)
+			assert.True(t, p.IsLegal(m))
+			assert.Equal(t, tt.want, p.Play(m).FEN())
+		})
+	}
+}
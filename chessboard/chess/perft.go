@@ -0,0 +1,26 @@
+package chess
+
+// Perft return number of leaf nodes of legal move tree with depth.
+func (p *Position) Perft(depth int) int {
+	if depth <= 0 {
+		return 1
+	}
+	moves := p.LegalMoves()
+	if depth == 1 {
+		return len(moves)
+	}
+	nodes := 0
+	for _, m := range moves {
+		nodes += p.Play(m).Perft(depth - 1)
+	}
+	return nodes
+}
+
+// Divide return perft leaf nodes count for every legal move.
+func (p *Position) Divide(depth int) map[Move]int {
+	nodes := make(map[Move]int)
+	for _, m := range p.LegalMoves() {
+		nodes[m] = p.Play(m).Perft(depth - 1)
+	}
+	return nodes
+}
@@ -0,0 +1,76 @@
+package chess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Perft results from https://www.chessprogramming.org/Perft_Results
+func TestPosition_Perft(t *testing.T) {
+	tests := []struct {
+		name  string
+		fen   string
+		nodes []int
+	}{
+		{
+			"initial position",
+			StartFEN,
+			[]int{1, 20, 400, 8902, 197281},
+		},
+		{
+			"kiwipete",
+			"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+			[]int{1, 48, 2039, 97862},
+		},
+		{
+			"position 3",
+			"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1",
+			[]int{1, 14, 191, 2812, 43238},
+		},
+		{
+			"position 4",
+			"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+			[]int{1, 6, 264, 9467},
+		},
+		{
+			"position 4 mirrored",
+			"r2q1rk1/pP1p2pp/Q4n2/bbp1p3/Np6/1B3NBn/pPPP1PPP/R3K2R b KQ - 0 1",
+			[]int{1, 6, 264, 9467},
+		},
+		{
+			"position 5",
+			"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8",
+			[]int{1, 44, 1486, 62379},
+		},
+		{
+			"position 6",
+			"r4rk1/1pp1qppp/p1np1n2/2b1p1B1/2B1P1b1/P1NP1N2/1PP1QPPP/R4RK1 w - - 0 10",
+			[]int{1, 46, 2079, 89890},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			for depth, want := range tt.nodes {
+				if testing.Short() && depth > 2 {
+					break
+				}
+				assert.Equal(t, want, p.Perft(depth), "depth %d", depth)
+			}
+		})
+	}
+}
+
+func TestPosition_Divide(t *testing.T) {
+	p := NewPosition()
+	got := p.Divide(2)
+	assert.Len(t, got, 20)
+	total := 0
+	for m, n := range got {
+		assert.Equal(t, 20, n, m.String())
+		total += n
+	}
+	assert.Equal(t, 400, total)
+}
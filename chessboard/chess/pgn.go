@@ -0,0 +1,155 @@
+package chess
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrPGN indicates that a value does not have the right syntax for the PGN game.
+	ErrPGN = errors.New("invalid PGN syntax")
+
+	tagPattern        = regexp.MustCompile(`^\[\s*(\w+)\s+"((?:[^"\\]|\\.)*)"\s*\]$`)
+	moveNumberPattern = regexp.MustCompile(`^\d+\.+`)
+	results           = map[string]bool{"1-0": true, "0-1": true, "1/2-1/2": true, "*": true}
+)
+
+// Game represent chess game recorded in Portable Game Notation.
+type Game struct {
+	Tags   map[string]string
+	Moves  []string
+	Result string
+}
+
+// ReadPGN read first game from reader in Portable Game Notation.
+func ReadPGN(r io.Reader) (*Game, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading pgn:%w", err)
+	}
+	text := string(src)
+	g := &Game{Tags: make(map[string]string)}
+	for i := 0; i < len(text) && g.Result == ""; i++ {
+		switch c := text[i]; {
+		case c == '[':
+			end := strings.IndexByte(text[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated tag:%w", ErrPGN)
+			}
+			tag := tagPattern.FindStringSubmatch(text[i : i+end+1])
+			if tag == nil {
+				return nil, fmt.Errorf("tag %s:%w", text[i:i+end+1], ErrPGN)
+			}
+			g.Tags[tag[1]] = strings.ReplaceAll(tag[2], `\"`, `"`)
+			i += end
+		case c == '{':
+			end := strings.IndexByte(text[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment:%w", ErrPGN)
+			}
+			i += end
+		case c == ';' || (c == '%' && (i == 0 || text[i-1] == '\n')):
+			if end := strings.IndexByte(text[i:], '\n'); end >= 0 {
+				i += end
+				continue
+			}
+			i = len(text)
+		case c == '(':
+			end, err := skipVariation(text[i:])
+			if err != nil {
+				return nil, err
+			}
+			i += end
+		case strings.IndexByte(")]}", c) >= 0:
+			return nil, fmt.Errorf("unexpected %q:%w", c, ErrPGN)
+		case strings.IndexByte(" \t\r\n", c) >= 0:
+		default:
+			end := strings.IndexAny(text[i:], " \t\r\n[]{}();")
+			if end < 0 {
+				end = len(text) - i
+			}
+			g.addToken(text[i : i+end])
+			i += end - 1
+		}
+	}
+	if len(g.Moves) == 0 && g.Result == "" && len(g.Tags) == 0 {
+		return nil, fmt.Errorf("empty game:%w", ErrPGN)
+	}
+	return g, nil
+}
+
+func (g *Game) addToken(token string) {
+	if results[token] {
+		g.Result = token
+		return
+	}
+	token = moveNumberPattern.ReplaceAllString(token, "")
+	if token == "" || token[0] == '$' {
+		return
+	}
+	g.Moves = append(g.Moves, token)
+}
+
+func skipVariation(text string) (int, error) {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return i, nil
+			}
+		case '{':
+			end := strings.IndexByte(text[i:], '}')
+			if end < 0 {
+				return 0, fmt.Errorf("unterminated comment:%w", ErrPGN)
+			}
+			i += end
+		}
+	}
+	return 0, fmt.Errorf("unterminated variation:%w", ErrPGN)
+}
+
+// Start return game starting position from FEN tag or standard one.
+func (g *Game) Start() (*Position, error) {
+	fen, ok := g.Tags["FEN"]
+	if !ok {
+		return NewPosition(), nil
+	}
+	p, err := ParseFEN(fen)
+	if err != nil {
+		return nil, fmt.Errorf("game start position:%w", err)
+	}
+	return p, nil
+}
+
+// Replay return game positions starting with initial one followed by position after every move.
+func (g *Game) Replay() ([]*Position, error) {
+	p, err := g.Start()
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*Position, 0, len(g.Moves)+1)
+	positions = append(positions, p)
+	for _, san := range g.Moves {
+		m, err := p.ParseSAN(san)
+		if err != nil {
+			return positions, fmt.Errorf("move %d%s %s:%w", p.FullMoveNumber, MoveNumberSuffix(p.Turn), san, err)
+		}
+		p = p.Play(m)
+		positions = append(positions, p)
+	}
+	return positions, nil
+}
+
+// MoveNumberSuffix return suffix written after move number for side to move.
+func MoveNumberSuffix(c Color) string {
+	if c == White {
+		return "."
+	}
+	return "..."
+}
@@ -0,0 +1,118 @@
+package chess
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPGN(t *testing.T) {
+	tests := []struct {
+		name      string
+		pgn       string
+		want      *Game
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"tags and moves",
+			"[Event \"Test \\\"game\\\"\"]\n[Result \"1-0\"]\n\n1. e4 e5 2.Nf3 {comment} Nc6 3. Bb5 $1 a6 1-0\n",
+			&Game{
+				Tags:   map[string]string{"Event": `Test "game"`, "Result": "1-0"},
+				Moves:  []string{"e4", "e5", "Nf3", "Nc6", "Bb5", "a6"},
+				Result: "1-0",
+			}, assert.NoError,
+		},
+		{
+			"variations and line comments",
+			"1. e4 (1. d4 d5 (1... Nf6) {x)} ) 1... c5 ; rest of line\n2. Nf3 *",
+			&Game{Tags: map[string]string{}, Moves: []string{"e4", "c5", "Nf3"}, Result: "*"},
+			assert.NoError,
+		},
+		{
+			"castling with zeros and second game",
+			"1. 0-0 1/2-1/2\n\n1. d4 *",
+			&Game{Tags: map[string]string{}, Moves: []string{"0-0"}, Result: "1/2-1/2"},
+			assert.NoError,
+		},
+		{"without result", "1. e4 e5", &Game{Tags: map[string]string{}, Moves: []string{"e4", "e5"}}, assert.NoError},
+		{"empty game", " \n", nil, assert.Error},
+		{"unterminated tag", "[Event \"x\"", nil, assert.Error},
+		{"invalid tag", "[Event x]", nil, assert.Error},
+		{"unterminated comment", "1. e4 {", nil, assert.Error},
+		{"unterminated variation", "1. e4 (1. d4", nil, assert.Error},
+		{"unterminated variation comment", "1. e4 (1. d4 {", nil, assert.Error},
+		{"unexpected variation end", "1. e4 )", nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadPGN(strings.NewReader(tt.pgn))
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadPGN_readerError(t *testing.T) {
+	_, err := ReadPGN(iotest.ErrReader(errors.New("test")))
+	assert.Error(t, err)
+}
+
+func TestGame_Replay(t *testing.T) {
+	tests := []struct {
+		name    string
+		game    *Game
+		want    string
+		wantErr error
+	}{
+		{
+			"scholar's mate",
+			&Game{Moves: []string{"e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7#"}},
+			"r1bqkb1r/pppp1Qpp/2n2n2/4p3/2B1P3/8/PPPP1PPP/RNB1K1NR b KQkq - 0 4", nil,
+		},
+		{
+			"from fen tag",
+			&Game{Tags: map[string]string{"FEN": "4k3/8/8/8/8/8/8/R3K3 w Q - 0 1"}, Moves: []string{"O-O-O"}},
+			"4k3/8/8/8/8/8/8/2KR4 b - - 1 1", nil,
+		},
+		{
+			"illegal move",
+			&Game{Moves: []string{"e4", "e5", "Ke3"}},
+			"", ErrIllegalMove,
+		},
+		{
+			"ambiguous move",
+			&Game{Moves: []string{"d4", "a6", "Nf3", "a5", "Nd2"}},
+			"", ErrAmbiguousMove,
+		},
+		{
+			"invalid fen tag",
+			&Game{Tags: map[string]string{"FEN": "8/8/8/8/8/8/8/8 w - -"}},
+			"", ErrPosition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.game.Replay()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got, len(tt.game.Moves)+1)
+			assert.Equal(t, tt.want, got[len(got)-1].FEN())
+		})
+	}
+}
+
+func TestGame_Replay_errorMessage(t *testing.T) {
+	g := &Game{Moves: []string{"e4", "e5", "Ke3"}}
+	_, err := g.Replay()
+	assert.EqualError(t, err, "move 2. Ke3:illegal move")
+
+	g = &Game{Moves: []string{"e4", "Kf7"}}
+	_, err = g.Replay()
+	assert.EqualError(t, err, "move 1... Kf7:illegal move")
+}
@@ -0,0 +1,128 @@
+package chess
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrSquare indicates that a value is not a valid square name.
+	ErrSquare = errors.New("square should be a file a-h followed by a rank 1-8")
+)
+
+// Color represent side color.
+type Color int8
+
+const (
+	// White side.
+	White Color = iota
+	// Black side.
+	Black
+)
+
+// Other return opposite color.
+func (c Color) Other() Color {
+	return 1 - c
+}
+
+// String return name of color.
+func (c Color) String() string {
+	if c == White {
+		return "white"
+	}
+	return "black"
+}
+
+// PieceType represent kind of piece.
+type PieceType int8
+
+// Piece types.
+const (
+	NoPieceType PieceType = iota
+	Pawn
+	Knight
+	Bishop
+	Rook
+	Queen
+	King
+)
+
+// Piece represent colored piece, zero value is an empty square.
+type Piece struct {
+	Type  PieceType
+	Color Color
+}
+
+// NoPiece represent empty square.
+var NoPiece = Piece{}
+
+var pieceLetters = map[PieceType]rune{
+	Pawn: 'p', Knight: 'n', Bishop: 'b', Rook: 'r', Queen: 'q', King: 'k',
+}
+
+// Letter return FEN letter of piece, zero for empty square.
+func (p Piece) Letter() rune {
+	l, ok := pieceLetters[p.Type]
+	if !ok {
+		return 0
+	}
+	if p.Color == White {
+		return l - 'a' + 'A'
+	}
+	return l
+}
+
+// PieceFromLetter return piece represented by FEN letter.
+func PieceFromLetter(l rune) (Piece, bool) {
+	c := Black
+	if l >= 'A' && l <= 'Z' {
+		c, l = White, l-'A'+'a'
+	}
+	for t, pl := range pieceLetters {
+		if pl == l {
+			return Piece{Type: t, Color: c}, true
+		}
+	}
+	return NoPiece, false
+}
+
+// Square represent board square index, a1 is 0 and h8 is 63.
+type Square int8
+
+// NoSquare represent missing square.
+const NoSquare Square = -1
+
+// NewSquare return square with zero based file and rank.
+func NewSquare(file, rank int) Square {
+	return Square(rank*8 + file)
+}
+
+// ParseSquare parse algebraic square name like e4.
+func ParseSquare(s string) (Square, error) {
+	if len(s) != 2 || s[0] < 'a' || s[0] > 'h' || s[1] < '1' || s[1] > '8' {
+		return NoSquare, fmt.Errorf("parse square %q:%w", s, ErrSquare)
+	}
+	return NewSquare(int(s[0]-'a'), int(s[1]-'1')), nil
+}
+
+// File return zero based file of square.
+func (s Square) File() int {
+	return int(s) % 8
+}
+
+// Rank return zero based rank of square.
+func (s Square) Rank() int {
+	return int(s) / 8
+}
+
+// String return algebraic square name.
+func (s Square) String() string {
+	if s == NoSquare {
+		return "-"
+	}
+	return string([]byte{byte('a' + s.File()), byte('1' + s.Rank())})
+}
+
+func onBoard(file, rank int) bool {
+	return file >= 0 && file < 8 && rank >= 0 && rank < 8
+}
@@ -0,0 +1,79 @@
+package chess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPiece_Letter(t *testing.T) {
+	tests := []struct {
+		name  string
+		piece Piece
+		want  rune
+	}{
+		{"white king", Piece{Type: King, Color: White}, 'K'},
+		{"black knight", Piece{Type: Knight, Color: Black}, 'n'},
+		{"empty square", NoPiece, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.piece.Letter())
+		})
+	}
+}
+
+func TestPieceFromLetter(t *testing.T) {
+	tests := []struct {
+		name   string
+		letter rune
+		want   Piece
+		wantOk bool
+	}{
+		{"white queen", 'Q', Piece{Type: Queen, Color: White}, true},
+		{"black pawn", 'p', Piece{Type: Pawn, Color: Black}, true},
+		{"unknown letter", 'x', NoPiece, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PieceFromLetter(tt.letter)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func TestParseSquare(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      Square
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"a1", "a1", 0, assert.NoError},
+		{"e4", "e4", 28, assert.NoError},
+		{"h8", "h8", 63, assert.NoError},
+		{"invalid file", "i1", NoSquare, assert.Error},
+		{"invalid rank", "a9", NoSquare, assert.Error},
+		{"too long", "a10", NoSquare, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSquare(tt.s)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSquare_String(t *testing.T) {
+	assert.Equal(t, "e4", NewSquare(4, 3).String())
+	assert.Equal(t, "-", NoSquare.String())
+}
+
+func TestColor(t *testing.T) {
+	assert.Equal(t, Black, White.Other())
+	assert.Equal(t, White, Black.Other())
+	assert.Equal(t, "white", White.String())
+	assert.Equal(t, "black", Black.String())
+}
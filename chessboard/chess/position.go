@@ -0,0 +1,248 @@
+package chess
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+// StartFEN is a FEN of standard starting position.
+const StartFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+var (
+	// ErrPosition indicates that a position is not valid chess position.
+	ErrPosition = errors.New("invalid chess position")
+)
+
+// Castling represent castling rights bit set.
+type Castling uint8
+
+// Castling rights.
+const (
+	WhiteKingSide Castling = 1 << iota
+	WhiteQueenSide
+	BlackKingSide
+	BlackQueenSide
+)
+
+var castlingLetters = []struct {
+	right  Castling
+	letter rune
+}{
+	{WhiteKingSide, 'K'}, {WhiteQueenSide, 'Q'}, {BlackKingSide, 'k'}, {BlackQueenSide, 'q'},
+}
+
+// String return castling rights in FEN notation.
+func (c Castling) String() string {
+	var b strings.Builder
+	for _, cl := range castlingLetters {
+		if c&cl.right != 0 {
+			b.WriteRune(cl.letter)
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
+
+// Position represent chess position.
+type Position struct {
+	Squares        [64]Piece
+	Turn           Color
+	Castling       Castling
+	EnPassant      Square
+	HalfMoveClock  int
+	FullMoveNumber int
+}
+
+// NewPosition return standard starting position.
+func NewPosition() *Position {
+	p, _ := ParseFEN(StartFEN)
+	return p
+}
+
+// ParseFEN parse position from Forsyth–Edwards Notation,
+// halfmove clock and fullmove number fields are optional.
+func ParseFEN(fen string) (*Position, error) {
+	fields := strings.Fields(fen)
+	if len(fields) != 4 && len(fields) != 6 {
+		return nil, fmt.Errorf("fen fields count %d:%w", len(fields), board.ErrFEN)
+	}
+	br, err := board.FromFEN(fields[0], board.BlackSymbol, board.WhiteSymbol, board.ASCIIPieces)
+	if err != nil {
+		return nil, fmt.Errorf("parse fen:%w", err)
+	}
+	if br.Height != 8 || br.Width != 8 {
+		return nil, fmt.Errorf("fen board %dx%d:%w", br.Height, br.Width, board.ErrFEN)
+	}
+
+	p := &Position{EnPassant: NoSquare, FullMoveNumber: 1}
+	for i, rank := range br.Pieces {
+		for j, l := range rank {
+			if l != 0 {
+				p.Squares[NewSquare(j, 7-i)], _ = PieceFromLetter(l)
+			}
+		}
+	}
+	if p.Turn, err = parseTurn(fields[1]); err != nil {
+		return nil, err
+	}
+	if p.Castling, err = parseCastling(fields[2]); err != nil {
+		return nil, err
+	}
+	if fields[3] != "-" {
+		if p.EnPassant, err = ParseSquare(fields[3]); err != nil {
+			return nil, fmt.Errorf("fen en passant:%w", err)
+		}
+	}
+	if len(fields) == 6 {
+		p.HalfMoveClock, err = strconv.Atoi(fields[4])
+		if err != nil || p.HalfMoveClock < 0 {
+			return nil, fmt.Errorf("fen halfmove clock %q:%w", fields[4], board.ErrFEN)
+		}
+		p.FullMoveNumber, err = strconv.Atoi(fields[5])
+		if err != nil || p.FullMoveNumber <= 0 {
+			return nil, fmt.Errorf("fen fullmove number %q:%w", fields[5], board.ErrFEN)
+		}
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func parseTurn(s string) (Color, error) {
+	switch s {
+	case "w":
+		return White, nil
+	case "b":
+		return Black, nil
+	default:
+		return White, fmt.Errorf("fen active color %q:%w", s, board.ErrFEN)
+	}
+}
+
+func parseCastling(s string) (Castling, error) {
+	var c Castling
+	if s == "-" {
+		return c, nil
+	}
+	for _, l := range s {
+		found := false
+		for _, cl := range castlingLetters {
+			if cl.letter == l {
+				c, found = c|cl.right, true
+			}
+		}
+		if !found {
+			return c, fmt.Errorf("fen castling %q:%w", s, board.ErrFEN)
+		}
+	}
+	return c, nil
+}
+
+func (p *Position) validate() error {
+	for _, c := range []Color{White, Black} {
+		kings := 0
+		for _, pc := range p.Squares {
+			if pc == (Piece{Type: King, Color: c}) {
+				kings++
+			}
+		}
+		if kings != 1 {
+			return fmt.Errorf("%s has %d kings:%w", c, kings, ErrPosition)
+		}
+	}
+	for f := 0; f < 8; f++ {
+		if p.Squares[NewSquare(f, 0)].Type == Pawn || p.Squares[NewSquare(f, 7)].Type == Pawn {
+			return fmt.Errorf("pawn on first or last rank:%w", ErrPosition)
+		}
+	}
+	for _, cr := range castlingRules {
+		c := White
+		if cr.king.Rank() == 7 {
+			c = Black
+		}
+		if p.Castling&cr.right != 0 &&
+			(p.Squares[cr.king] != (Piece{Type: King, Color: c}) || p.Squares[cr.rook] != (Piece{Type: Rook, Color: c})) {
+			return fmt.Errorf("castling %s without king and rook on home squares:%w", cr.right, ErrPosition)
+		}
+	}
+	if p.EnPassant != NoSquare && !p.validEnPassant() {
+		return fmt.Errorf("en passant square %s:%w", p.EnPassant, ErrPosition)
+	}
+	if p.InCheck(p.Turn.Other()) {
+		return fmt.Errorf("%s king can be captured:%w", p.Turn.Other(), ErrPosition)
+	}
+	return nil
+}
+
+// validEnPassant indicate if en passant square is empty square just passed by enemy pawn
+// double step, it is on 6th rank for white to move and on 3rd rank for black to move.
+func (p *Position) validEnPassant() bool {
+	rank, dir := 5, -1
+	if p.Turn == Black {
+		rank, dir = 2, 1
+	}
+	sq := p.EnPassant
+	return sq.Rank() == rank && p.Squares[sq] == NoPiece &&
+		p.Squares[NewSquare(sq.File(), rank+dir)] == (Piece{Type: Pawn, Color: p.Turn.Other()})
+}
+
+// FEN return position in Forsyth–Edwards Notation.
+func (p *Position) FEN() string {
+	return fmt.Sprintf("%s %c %s %s %d %d",
+		p.Placement(), "wb"[p.Turn], p.Castling, p.EnPassant, p.HalfMoveClock, p.FullMoveNumber)
+}
+
+// Placement return piece placement field of position FEN.
+func (p *Position) Placement() string {
+	var b strings.Builder
+	for r := 7; r >= 0; r-- {
+		empty := 0
+		for f := 0; f < 8; f++ {
+			l := p.Squares[NewSquare(f, r)].Letter()
+			if l == 0 {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				b.WriteByte(byte('0' + empty))
+				empty = 0
+			}
+			b.WriteRune(l)
+		}
+		if empty > 0 {
+			b.WriteByte(byte('0' + empty))
+		}
+		if r > 0 {
+			b.WriteRune('/')
+		}
+	}
+	return b.String()
+}
+
+// Board return board with position pieces drawn with pieces set.
+func (p *Position) Board(pieces board.PieceSet) (*board.Board, error) {
+	return board.FromFEN(p.Placement(), board.BlackSymbol, board.WhiteSymbol, pieces)
+}
+
+// King return square of king with color.
+func (p *Position) King(c Color) Square {
+	for sq, pc := range p.Squares {
+		if pc.Type == King && pc.Color == c {
+			return Square(sq)
+		}
+	}
+	return NoSquare
+}
+
+// InCheck indicate if king with color is attacked.
+func (p *Position) InCheck(c Color) bool {
+	return p.IsAttacked(p.King(c), c.Other())
+}
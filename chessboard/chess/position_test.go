@@ -0,0 +1,100 @@
+package chess
+
+import (
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFEN(t *testing.T) {
+	tests := []struct {
+		name      string
+		fen       string
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"start position", StartFEN, StartFEN, assert.NoError},
+		{
+			"without clocks",
+			"4k3/8/8/3pP3/8/8/8/4K3 w - d6",
+			"4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", assert.NoError,
+		},
+		{
+			"partial castling",
+			"r3k3/8/8/8/8/8/8/4K2R b Kq - 3 20",
+			"r3k3/8/8/8/8/8/8/4K2R b Kq - 3 20", assert.NoError,
+		},
+		{"too few fields", "8/8/8/8/8/8/8/8 w", "", assert.Error},
+		{"not 8x8 board", "4k3/8/8/8/8/8/4K3 w - -", "", assert.Error},
+		{"invalid color", "4k3/8/8/8/8/8/8/4K3 x - -", "", assert.Error},
+		{"invalid castling", "4k3/8/8/8/8/8/8/4K3 w X -", "", assert.Error},
+		{"invalid en passant", "4k3/8/8/8/8/8/8/4K3 w - z9", "", assert.Error},
+		{
+			"black en passant",
+			"4k3/8/8/8/3Pp3/8/8/4K3 b - d3 0 1",
+			"4k3/8/8/8/3Pp3/8/8/4K3 b - d3 0 1", assert.NoError,
+		},
+		{"en passant on wrong rank", "4k3/8/8/8/8/8/PP6/4K3 w - a3 0 1", "", assert.Error},
+		{"en passant on rank of other side", "4k3/8/8/8/3Pp3/8/8/4K3 w - d3 0 1", "", assert.Error},
+		{"en passant without pawn", "4k3/8/8/8/8/8/8/4K3 w - d6 0 1", "", assert.Error},
+		{"en passant by own pawn", "4k3/8/8/3P4/8/8/8/4K3 w - d6 0 1", "", assert.Error},
+		{"occupied en passant square", "4k3/8/3n4/3p4/8/8/8/4K3 w - d6 0 1", "", assert.Error},
+		{"castling without rook", "4k3/8/8/8/8/8/8/4K3 w K -", "", assert.Error},
+		{"castling with moved king", "r3k3/8/8/8/8/8/8/3K3R w K -", "", assert.Error},
+		{"castling with enemy rook", "r3k3/8/8/8/8/8/8/4K2r w K -", "", assert.Error},
+		{"black castling without rook", "4k3/8/8/8/8/8/8/4K3 w q -", "", assert.Error},
+		{"invalid halfmove clock", "4k3/8/8/8/8/8/8/4K3 w - - x 1", "", assert.Error},
+		{"invalid fullmove number", "4k3/8/8/8/8/8/8/4K3 w - - 0 0", "", assert.Error},
+		{"missing king", "8/8/8/8/8/8/8/4K3 w - -", "", assert.Error},
+		{"pawn on last rank", "P3k3/8/8/8/8/8/8/4K3 w - -", "", assert.Error},
+		{"side not to move in check", "4k3/4R3/8/8/8/8/8/4K3 w - -", "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFEN(tt.fen)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got.FEN())
+		})
+	}
+}
+
+func TestPosition_Board(t *testing.T) {
+	p, err := ParseFEN("k7/8/8/8/8/8/8/7K w - -")
+	assert.NoError(t, err)
+	got, err := p.Board(board.ASCIIPieces)
+	assert.NoError(t, err)
+	assert.Equal(t, "k* * * *\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n * * * *\n* * * *K\n", got.String())
+}
+
+func TestPosition_InCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		fen   string
+		color Color
+		want  bool
+	}{
+		{"start position", StartFEN, White, false},
+		{"rook check", "4k3/8/8/8/8/8/8/r3K3 w - -", White, true},
+		{"pawn check", "4k3/8/8/8/8/8/3p4/4K3 w - -", White, true},
+		{"knight check", "4k3/8/8/8/8/5n2/8/4K3 w - -", White, true},
+		{"blocked bishop", "4k3/8/8/8/1b6/2P5/8/4K3 w - -", White, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, p.InCheck(tt.color))
+		})
+	}
+}
+
+func TestCastling_String(t *testing.T) {
+	assert.Equal(t, "KQkq", (WhiteKingSide | WhiteQueenSide | BlackKingSide | BlackQueenSide).String())
+	assert.Equal(t, "Qk", (WhiteQueenSide | BlackKingSide).String())
+	assert.Equal(t, "-", Castling(0).String())
+}
@@ -0,0 +1,70 @@
+package chess
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrAmbiguousMove indicates that a move matches more than one legal move.
+	ErrAmbiguousMove = errors.New("ambiguous move")
+
+	sanPattern = regexp.MustCompile(`^([NBRQK])?([a-h])?([1-8])?x?([a-h][1-8])(?:=?([NBRQ]))?$`)
+)
+
+// ParseSAN return legal move of position written in Standard Algebraic Notation.
+func (p *Position) ParseSAN(san string) (Move, error) {
+	s := strings.TrimRight(san, "+#!?")
+	switch strings.ReplaceAll(s, "0", "O") {
+	case "O-O":
+		return p.matchMove(func(m Move) bool {
+			return m.From == p.King(p.Turn) && m.To.File() == 6 && m.From.File() == 4
+		})
+	case "O-O-O":
+		return p.matchMove(func(m Move) bool {
+			return m.From == p.King(p.Turn) && m.To.File() == 2 && m.From.File() == 4
+		})
+	}
+
+	parts := sanPattern.FindStringSubmatch(s)
+	if parts == nil {
+		return Move{}, ErrIllegalMove
+	}
+	pieceType := Pawn
+	if parts[1] != "" {
+		pc, _ := PieceFromLetter(rune(parts[1][0]))
+		pieceType = pc.Type
+	}
+	to, _ := ParseSquare(parts[4])
+	promotion := NoPieceType
+	if parts[5] != "" {
+		pc, _ := PieceFromLetter(rune(parts[5][0]))
+		promotion = pc.Type
+	}
+
+	return p.matchMove(func(m Move) bool {
+		from := m.From.String()
+		return m.To == to && m.Promotion == promotion &&
+			p.Squares[m.From].Type == pieceType &&
+			(parts[2] == "" || from[0] == parts[2][0]) &&
+			(parts[3] == "" || from[1] == parts[3][0])
+	})
+}
+
+func (p *Position) matchMove(match func(m Move) bool) (Move, error) {
+	found := make([]Move, 0, 1)
+	for _, m := range p.LegalMoves() {
+		if match(m) {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Move{}, ErrIllegalMove
+	case 1:
+		return found[0], nil
+	default:
+		return Move{}, ErrAmbiguousMove
+	}
+}
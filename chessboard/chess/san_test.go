@@ -0,0 +1,47 @@
+package chess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPosition_ParseSAN(t *testing.T) {
+	tests := []struct {
+		name    string
+		fen     string
+		san     string
+		want    string
+		wantErr error
+	}{
+		{"pawn push", StartFEN, "e4", "e2e4", nil},
+		{"knight move", StartFEN, "Nf3", "g1f3", nil},
+		{"check annotation", StartFEN, "Nc3+!?", "b1c3", nil},
+		{"pawn capture", "4k3/8/8/3p4/4P3/8/8/4K3 w - -", "exd5", "e4d5", nil},
+		{"en passant", "4k3/8/8/3pP3/8/8/8/4K3 w - d6", "exd6", "e5d6", nil},
+		{"promotion", "8/P6k/8/8/8/8/8/K7 w - -", "a8=Q", "a7a8q", nil},
+		{"promotion without equal sign", "8/P6k/8/8/8/8/8/K7 w - -", "a8N", "a7a8n", nil},
+		{"missing promotion", "8/P6k/8/8/8/8/8/K7 w - -", "a8", "", ErrIllegalMove},
+		{"king side castling", "r3k2r/8/8/8/8/8/8/R3K2R w KQkq -", "O-O", "e1g1", nil},
+		{"queen side castling with zeros", "r3k2r/8/8/8/8/8/8/R3K2R b KQkq -", "0-0-0", "e8c8", nil},
+		{"file disambiguation", "4k3/8/8/8/8/8/8/1N2KN2 w - -", "Nbd2", "b1d2", nil},
+		{"rank disambiguation", "4k3/8/8/R7/8/8/8/R3K3 w - -", "R1a3", "a1a3", nil},
+		{"ambiguous move", "4k3/8/8/8/8/8/8/1N2KN2 w - -", "Nd2", "", ErrAmbiguousMove},
+		{"illegal move", StartFEN, "e5", "", ErrIllegalMove},
+		{"illegal castling", StartFEN, "O-O", "", ErrIllegalMove},
+		{"invalid syntax", StartFEN, "Zz9", "", ErrIllegalMove},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			got, err := p.ParseSAN(tt.san)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package chess
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FiftyMoveLimit is a halfmove clock value at which game is drawn by fifty-move rule.
+const FiftyMoveLimit = 100
+
+// IsCheckmate indicate if side to move is in check and has no legal moves.
+func (p *Position) IsCheckmate() bool {
+	return p.InCheck(p.Turn) && len(p.LegalMoves()) == 0
+}
+
+// IsStalemate indicate if side to move is not in check and has no legal moves.
+func (p *Position) IsStalemate() bool {
+	return !p.InCheck(p.Turn) && len(p.LegalMoves()) == 0
+}
+
+// RepetitionKey return FEN fields positions are compared by for repetition:
+// placement, turn, castling rights and en passant square when capture on it is legal.
+func (p *Position) RepetitionKey() string {
+	ep := NoSquare
+	for _, m := range p.LegalMoves() {
+		if m.To == p.EnPassant && p.Squares[m.From].Type == Pawn {
+			ep = p.EnPassant
+			break
+		}
+	}
+	return fmt.Sprintf("%s %c %s %s", p.Placement(), "wb"[p.Turn], p.Castling, ep)
+}
+
+// ParseMoveText return legal move of position written in coordinate notation like e2e4
+// or in Standard Algebraic Notation like Nf3.
+func (p *Position) ParseMoveText(s string) (Move, error) {
+	s = strings.TrimSpace(s)
+	if m, err := ParseMove(s); err == nil {
+		if !p.IsLegal(m) {
+			return Move{}, fmt.Errorf("move %s:%w", s, ErrIllegalMove)
+		}
+		return m, nil
+	}
+	m, err := p.ParseSAN(s)
+	if err != nil {
+		return Move{}, fmt.Errorf("move %s:%w", s, err)
+	}
+	return m, nil
+}
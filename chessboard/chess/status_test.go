@@ -0,0 +1,80 @@
+package chess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPosition_IsCheckmate(t *testing.T) {
+	tests := []struct {
+		name          string
+		fen           string
+		wantCheckmate bool
+		wantStalemate bool
+	}{
+		{"start", StartFEN, false, false},
+		{"fool's mate", "rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", true, false},
+		{"check with escape", "4k3/8/8/8/8/8/8/r3K3 w - -", false, false},
+		{"stalemate", "7k/5Q2/6K1/8/8/8/8/8 b - -", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCheckmate, p.IsCheckmate())
+			assert.Equal(t, tt.wantStalemate, p.IsStalemate())
+		})
+	}
+}
+
+func TestPosition_RepetitionKey(t *testing.T) {
+	tests := []struct {
+		name string
+		fen  string
+		want string
+	}{
+		{"start", StartFEN, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq -"},
+		{"clocks ignored", "4k3/8/8/8/8/8/8/4K3 b - - 12 40", "4k3/8/8/8/8/8/8/4K3 b - -"},
+		{"en passant capture", "4k3/8/8/3pP3/8/8/8/4K3 w - d6", "4k3/8/8/3pP3/8/8/8/4K3 w - d6"},
+		{"en passant without capture", "4k3/8/8/3p4/8/8/8/4K3 w - d6", "4k3/8/8/3p4/8/8/8/4K3 w - -"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, p.RepetitionKey())
+		})
+	}
+}
+
+func TestPosition_ParseMoveText(t *testing.T) {
+	tests := []struct {
+		name    string
+		fen     string
+		text    string
+		want    string
+		wantErr error
+	}{
+		{"coordinate", StartFEN, "e2e4", "e2e4", nil},
+		{"coordinate promotion", "8/P6k/8/8/8/8/8/K7 w - -", "a7a8q", "a7a8q", nil},
+		{"san", StartFEN, "Nf3", "g1f3", nil},
+		{"san with spaces", StartFEN, " d4\n", "d2d4", nil},
+		{"illegal coordinate", StartFEN, "e2e5", "", ErrIllegalMove},
+		{"illegal san", StartFEN, "Ke2", "", ErrIllegalMove},
+		{"ambiguous san", "4k3/8/8/8/8/8/8/1N2KN2 w - -", "Nd2", "", ErrAmbiguousMove},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			got, err := p.ParseMoveText(tt.text)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/editor"
+)
+
+var (
+	// ErrEditFormat indicates that edit command called with unknown save format.
+	ErrEditFormat = errors.New("edit format should be one of text, fen, json")
+	// ErrOutput indicates that command called without output file.
+	ErrOutput = errors.New("output file should be set")
+)
+
+// EditParameters represent edit command parameters.
+type EditParameters struct {
+	// Height and Width are sizes of empty board, zero when board is read from FEN or input.
+	Height  int
+	Width   int
+	FEN     string
+	Input   string
+	Unicode bool
+	Format  string
+	Output  string
+}
+
+func parseEditParameters(args []string) (*EditParameters, error) {
+	p := &EditParameters{}
+	fs := flag.NewFlagSet("edit", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.FEN, "fen", "", "edit board with FEN piece placement")
+	fs.StringVar(&p.Input, "input", "", "edit text board of file")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw pieces with unicode glyphs")
+	fs.StringVar(&p.Format, "format", "text", "save format: text, fen or json")
+	fs.StringVar(&p.Output, "o", "", "file board is saved to")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	switch p.Format {
+	case "text", "fen", "json":
+	default:
+		return nil, fmt.Errorf("parse param format: %w", ErrEditFormat)
+	}
+	if p.Output == "" {
+		return nil, fmt.Errorf("parse param o: %w", ErrOutput)
+	}
+	if p.FEN != "" && p.Input != "" {
+		return nil, fmt.Errorf("fen and input flags together:%w", ErrParameters)
+	}
+	if p.Input == "-" {
+		return nil, fmt.Errorf("input from keyboard reader:%w", ErrParameters)
+	}
+	if (p.FEN != "" || p.Input != "") && fs.NArg() == 0 {
+		return p, nil
+	}
+	height, width, err := parseSize(fs.Args())
+	if err != nil {
+		return nil, err
+	}
+	p.Height, p.Width = height, width
+
+	return p, nil
+}
+
+func runEdit(r io.Reader, w io.Writer, args []string) error {
+	p, err := parseEditParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing edit parameters:%w", err)
+	}
+	if f, ok := r.(*os.File); ok && isTerminal(f) {
+		restore, err := rawMode(f)
+		if err != nil {
+			return fmt.Errorf("edit raw terminal:%w", err)
+		}
+		defer restore()
+	}
+	return Edit(r, w, p)
+}
+
+// Edit runs board editor reading keys from reader and drawing to writer with edit parameters,
+// board is saved to output file on save key.
+func Edit(r io.Reader, w io.Writer, p *EditParameters) error {
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	b, err := editBoard(p, pieces)
+	if err != nil {
+		return fmt.Errorf("edit creating board:%w", err)
+	}
+	e := editor.New(b, pieces, func(b *board.Board) error {
+		return saveBoard(b, p.Format, p.Output)
+	})
+	if err := e.Run(r, w); err != nil {
+		return fmt.Errorf("editing board:%w", err)
+	}
+	return nil
+}
+
+// editBoard return board of FEN, input file or sizes of parameters
+// with pieces drawn with pieces set.
+func editBoard(p *EditParameters, pieces board.PieceSet) (*board.Board, error) {
+	var b *board.Board
+	var err error
+	switch {
+	case p.FEN != "":
+		b, err = board.FromFEN(p.FEN, board.BlackSymbol, board.WhiteSymbol, pieces)
+	case p.Input != "":
+		b, err = readBoard(nil, &Parameters{Input: p.Input, Pieces: true, AnySymbols: true, Height: p.Height, Width: p.Width})
+	default:
+		b, err = board.NewBoard(p.Height, p.Width, board.BlackSymbol, board.WhiteSymbol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.FEN != "" && p.Height > 0 && (b.Height != p.Height || b.Width != p.Width) {
+		return nil, fmt.Errorf("board %dx%d, want %dx%d:%w", b.Height, b.Width, p.Height, p.Width, board.ErrSize)
+	}
+	for i := 0; i < b.Height; i++ {
+		for j := 0; j < b.Width; j++ {
+			if letter := b.Piece(i, j); letter != 0 {
+				if err := b.SetPiece(i, j, letter, pieces); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return b, nil
+}
+
+// saveBoard write board in text, fen or json format to file,
+// board with square symbols drawn by editor is saved only as text.
+func saveBoard(b *board.Board, format, name string) error {
+	if format != "text" {
+		if err := b.CheckSymbols(); err != nil {
+			return fmt.Errorf("saving board as %s:%w", format, err)
+		}
+	}
+	return writeFile(name, func(w io.Writer) error {
+		var err error
+		switch format {
+		case "fen":
+			_, err = fmt.Fprintln(w, b.FEN())
+		case "json":
+			err = b.WriteJSON(w)
+		default:
+			err = b.Write(w)
+		}
+		if err != nil {
+			return fmt.Errorf("saving board:%w", err)
+		}
+		return nil
+	})
+}
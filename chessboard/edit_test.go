@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseEditParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *EditParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"size", args{[]string{"-o", "out.txt", "3", "4"}},
+			&EditParameters{Height: 3, Width: 4, Format: "text", Output: "out.txt"}, assert.NoError,
+		},
+		{
+			"fen without size", args{[]string{"-fen", "8/8", "-unicode", "-format", "fen", "-o", "out.fen"}},
+			&EditParameters{FEN: "8/8", Unicode: true, Format: "fen", Output: "out.fen"}, assert.NoError,
+		},
+		{
+			"input with size", args{[]string{"-input", "in.txt", "-format", "json", "-o", "out.json", "2", "2"}},
+			&EditParameters{Height: 2, Width: 2, Input: "in.txt", Format: "json", Output: "out.json"}, assert.NoError,
+		},
+		{"missing output", args{[]string{"3", "4"}}, nil, assert.Error},
+		{"unknown format", args{[]string{"-format", "png", "-o", "out.png", "3", "4"}}, nil, assert.Error},
+		{"fen and input", args{[]string{"-fen", "8/8", "-input", "in.txt", "-o", "out.txt"}}, nil, assert.Error},
+		{"standard input", args{[]string{"-input", "-", "-o", "out.txt"}}, nil, assert.Error},
+		{"missing size", args{[]string{"-o", "out.txt"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown", "-o", "out.txt", "3", "4"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEditParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEdit(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.txt")
+	assert.NoError(t, os.WriteFile(input, []byte("#.\n.K\n"), 0o600))
+
+	tests := []struct {
+		name      string
+		p         *EditParameters
+		keys      string
+		want      string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"text",
+			&EditParameters{Height: 2, Width: 3, Format: "text"},
+			"R\x1b[C\x1b[Cs@\x13",
+			"R @\n * \n", assert.NoError,
+		},
+		{
+			"fen",
+			&EditParameters{FEN: "k1/2", Unicode: true, Format: "fen"},
+			" \x1b[BQ\x13",
+			"2/Q1\n", assert.NoError,
+		},
+		{
+			"json of input",
+			&EditParameters{Input: input, Format: "json"},
+			"\x1b[Cn\x13",
+			"{\"height\":2,\"width\":2,\"symbols\":[\".\",\"#\"],\"colors\":[[1,0],[0,1]],\"fen\":\"1n/1K\"}\n",
+			assert.NoError,
+		},
+		{
+			"unicode input",
+			&EditParameters{Input: input, Unicode: true, Format: "text"},
+			"\x13",
+			"#.\n.♔\n", assert.NoError,
+		},
+		{
+			"fen size mismatch",
+			&EditParameters{FEN: "k1/2", Height: 3, Width: 3, Format: "fen"},
+			"", "", assert.Error,
+		},
+		{
+			"invalid fen",
+			&EditParameters{FEN: "x", Format: "fen"},
+			"", "", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.p.Output = filepath.Join(dir, strings.ReplaceAll(tt.name, " ", "_"))
+			tt.assertion(t, Edit(strings.NewReader(tt.keys), &bytes.Buffer{}, tt.p))
+			got, _ := os.ReadFile(tt.p.Output)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestEdit_symbolsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	saved := filepath.Join(dir, "saved.txt")
+	assert.NoError(t, Edit(strings.NewReader("R\x1b[Cs@\x13"), &bytes.Buffer{}, &EditParameters{Height: 2, Width: 2, Format: "text", Output: saved}))
+
+	resaved := filepath.Join(dir, "resaved.txt")
+	assert.NoError(t, Edit(strings.NewReader("\x13"), &bytes.Buffer{}, &EditParameters{Input: saved, Format: "text", Output: resaved}))
+	want, err := os.ReadFile(saved)
+	assert.NoError(t, err)
+	got, err := os.ReadFile(resaved)
+	assert.NoError(t, err)
+	assert.Equal(t, "R@\n *\n", string(want))
+	assert.Equal(t, string(want), string(got))
+
+	b, err := readBoard(nil, &Parameters{Input: saved, Pieces: true, AnySymbols: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "R@\n *\n", b.String())
+
+	for _, format := range []string{"fen", "json"} {
+		out := filepath.Join(dir, "saved."+format)
+		assert.NoError(t, Edit(strings.NewReader("\x13"), &bytes.Buffer{}, &EditParameters{Input: saved, Format: format, Output: out}))
+		assert.NoFileExists(t, out)
+	}
+}
+
+func Test_saveBoard(t *testing.T) {
+	b, err := board.NewBoard(1, 1, "*", " ")
+	assert.NoError(t, err)
+	assert.Error(t, saveBoard(b, "text", filepath.Join(t.TempDir(), "missing", "out.txt")))
+
+	assert.NoError(t, b.SetSymbol(0, 0, "@"))
+	b2, err := board.NewBoard(1, 3, "*", " ")
+	assert.NoError(t, err)
+	assert.NoError(t, b2.SetSymbol(0, 2, "@"))
+	assert.NoError(t, saveBoard(b, "json", filepath.Join(t.TempDir(), "out.json")))
+	assert.ErrorIs(t, saveBoard(b2, "fen", filepath.Join(t.TempDir(), "out.fen")), board.ErrLegend)
+	assert.ErrorIs(t, saveBoard(b2, "json", filepath.Join(t.TempDir(), "out.json")), board.ErrLegend)
+}
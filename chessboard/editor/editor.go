@@ -0,0 +1,163 @@
+// Package editor edits board squares in terminal with a cursor.
+package editor
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	reverse     = "\x1b[7m"
+	reset       = "\x1b[0m"
+	help        = "arrows move, KQRBNPkqrbnp piece, space clear, s<symbol> symbol, ^S save, ^C quit"
+)
+
+// Editor represent board editing session.
+type Editor struct {
+	Board *board.Board
+	Row   int
+	Col   int
+	// Status is a message about last key.
+	Status string
+
+	pieces board.PieceSet
+	legend []string
+	save   func(*board.Board) error
+	symbol bool
+	done   bool
+}
+
+// New creates editor of board drawing pieces with pieces set,
+// save is called to store board when user asks for it.
+func New(br *board.Board, pieces board.PieceSet, save func(*board.Board) error) *Editor {
+	return &Editor{Board: br, pieces: pieces, legend: br.Legend(), save: save}
+}
+
+// Done reports whether user quit editor.
+func (e *Editor) Done() bool {
+	return e.done
+}
+
+// Run draws editor to writer after every key read from reader
+// until user quits or reader ends.
+func (e *Editor) Run(r io.Reader, w io.Writer) error {
+	kr := NewKeyReader(r)
+	for {
+		if err := e.Render(w); err != nil {
+			return err
+		}
+		if e.done {
+			return nil
+		}
+		k, err := kr.ReadKey()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading key:%w", err)
+		}
+		e.Handle(k)
+	}
+}
+
+// Handle applies key to board or cursor.
+func (e *Editor) Handle(k Key) {
+	e.Status = ""
+	if e.symbol {
+		e.symbol = false
+		if k < 0 || !unicode.IsPrint(rune(k)) {
+			e.Status = "symbol canceled"
+			return
+		}
+		e.set(e.Board.SetSymbol(e.Row, e.Col, string(rune(k))))
+		return
+	}
+	switch k {
+	case KeyUp:
+		e.move(-1, 0)
+	case KeyDown:
+		e.move(1, 0)
+	case KeyLeft:
+		e.move(0, -1)
+	case KeyRight:
+		e.move(0, 1)
+	case ' ', KeyBackspace, KeyCtrlH, KeyDelete:
+		e.set(e.Board.SetSymbol(e.Row, e.Col, e.baseSymbol()))
+	case 's':
+		e.symbol = true
+		e.Status = "type square symbol"
+	case KeyCtrlS:
+		if err := e.save(e.Board); err != nil {
+			e.Status = err.Error()
+			return
+		}
+		e.Status = "saved"
+	case KeyCtrlC, KeyCtrlD, KeyCtrlQ:
+		e.done = true
+	default:
+		if _, ok := e.pieces[rune(k)]; ok {
+			e.set(e.Board.SetPiece(e.Row, e.Col, rune(k), e.pieces))
+			return
+		}
+		e.Status = "unknown key"
+	}
+}
+
+func (e *Editor) move(dr, dc int) {
+	if r := e.Row + dr; r >= 0 && r < e.Board.Height {
+		e.Row = r
+	}
+	if c := e.Col + dc; c >= 0 && c < e.Board.Width {
+		e.Col = c
+	}
+}
+
+func (e *Editor) set(err error) {
+	if err != nil {
+		e.Status = err.Error()
+	}
+}
+
+// baseSymbol return symbol of cursor square color the board was created with.
+func (e *Editor) baseSymbol() string {
+	if c := e.Board.Color(e.Row, e.Col); c < len(e.legend) && e.legend[c] != "" {
+		return e.legend[c]
+	}
+	if e.Board.IsDark(e.Row, e.Col) {
+		return board.BlackSymbol
+	}
+	return board.WhiteSymbol
+}
+
+// Render write screen with rank labels, board with reversed cursor square,
+// cursor square name, keys help and status, lines end with carriage return for raw terminals.
+func (e *Editor) Render(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(clearScreen)
+	labelWidth := len(strconv.Itoa(e.Board.Height))
+	for i, row := range e.Board.Squares {
+		fmt.Fprintf(bw, "%*d ", labelWidth, e.Board.Height-i)
+		for j, s := range row {
+			if i == e.Row && j == e.Col {
+				s = reverse + s + reset
+			}
+			bw.WriteString(s)
+		}
+		bw.WriteString("\r\n")
+	}
+	fmt.Fprintf(bw, "\r\n%s\r\n%s\r\n", board.SquareName(e.Board.Height, e.Row, e.Col), help)
+	if e.Status != "" {
+		fmt.Fprintf(bw, "%s\r\n", e.Status)
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("rendering editor:%w", err)
+	}
+	return nil
+}
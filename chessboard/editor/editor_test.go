@@ -0,0 +1,110 @@
+package editor
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditor_Run(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantBoard  string
+		wantFEN    string
+		wantSaved  []string
+		wantStatus string
+	}{
+		{
+			"place pieces",
+			"K\x1b[C\x1b[Bn",
+			"K.#\n.n.\n#.#\n", "K2/1n1/3", nil, "",
+		},
+		{
+			"cursor stays inside",
+			"\x1b[A\x1b[D\x1b[D" + strings.Repeat("\x1b[B", 5) + "q",
+			"#.#\n.#.\nq.#\n", "3/3/q2", nil, "",
+		},
+		{
+			"clear square",
+			"R \x1b[Cb\x7f",
+			"#.#\n.#.\n#.#\n", "3/3/3", nil, "",
+		},
+		{
+			"custom symbol",
+			"s@\x1b[Cs\x1b[B",
+			"@.#\n.#.\n#.#\n", "3/3/3", nil, "symbol canceled",
+		},
+		{
+			"symbol canceled",
+			"s\x03",
+			"#.#\n.#.\n#.#\n", "3/3/3", nil, "symbol canceled",
+		},
+		{
+			"save and quit",
+			"Q\x13\x1b[Ck\x03N",
+			"Qk#\n.#.\n#.#\n", "Qk1/3/3", []string{"Q2/3/3"}, "",
+		},
+		{
+			"unknown key",
+			"x",
+			"#.#\n.#.\n#.#\n", "3/3/3", nil, "unknown key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			br, err := board.NewBoard(3, 3, "#", ".")
+			assert.NoError(t, err)
+			var saved []string
+			e := New(br, board.ASCIIPieces, func(b *board.Board) error {
+				saved = append(saved, b.FEN())
+				return nil
+			})
+			assert.NoError(t, e.Run(strings.NewReader(tt.input), &bytes.Buffer{}))
+			assert.Equal(t, tt.wantBoard, br.String())
+			assert.Equal(t, tt.wantFEN, br.FEN())
+			assert.Equal(t, tt.wantSaved, saved)
+			assert.Equal(t, tt.wantStatus, e.Status)
+		})
+	}
+}
+
+func TestEditor_Handle_saveError(t *testing.T) {
+	br, err := board.NewBoard(1, 1, "#", ".")
+	assert.NoError(t, err)
+	e := New(br, board.ASCIIPieces, func(*board.Board) error { return errors.New("disk full") })
+	e.Handle(KeyCtrlS)
+	assert.Equal(t, "disk full", e.Status)
+	assert.False(t, e.Done())
+	e.Handle(KeyCtrlQ)
+	assert.True(t, e.Done())
+}
+
+func TestEditor_Handle_patternSymbols(t *testing.T) {
+	br, err := board.NewPatternBoard(1, 3, board.VerticalStripes(1), []string{"a", "b", "c"})
+	assert.NoError(t, err)
+	e := New(br, board.UnicodePieces, func(*board.Board) error { return nil })
+	for _, k := range []Key{'K', KeyRight, 'k', KeyRight, 'Q', KeyDelete, KeyLeft, KeyDelete} {
+		e.Handle(k)
+	}
+	assert.Equal(t, "♔bc\n", br.String())
+}
+
+func TestEditor_Render(t *testing.T) {
+	br, err := board.FromFEN("10/10/10/10/10/10/10/10/10/K9", "#", ".", board.ASCIIPieces)
+	assert.NoError(t, err)
+	e := New(br, board.ASCIIPieces, func(*board.Board) error { return nil })
+	e.Row, e.Col = 9, 1
+	e.Status = "saved"
+
+	w := &bytes.Buffer{}
+	assert.NoError(t, e.Render(w))
+	lines := strings.Split(w.String(), "\r\n")
+	assert.Equal(t, clearScreen+"10 .#.#.#.#.#", lines[0])
+	assert.Equal(t, " 1 K"+reverse+"."+reset+"#.#.#.#.", lines[9])
+	assert.Equal(t, []string{"", "b1", help, "saved", ""}, lines[10:])
+}
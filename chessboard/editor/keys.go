@@ -0,0 +1,89 @@
+package editor
+
+import (
+	"bufio"
+	"io"
+)
+
+// Key represent pressed key, printable keys and control characters are their runes
+// and keys sent as escape sequences are negative.
+type Key rune
+
+// Control characters keys.
+const (
+	KeyCtrlC     Key = 0x03
+	KeyCtrlD     Key = 0x04
+	KeyBackspace Key = 0x7f
+	KeyCtrlH     Key = 0x08
+	KeyCtrlQ     Key = 0x11
+	KeyCtrlS     Key = 0x13
+	KeyEscape    Key = 0x1b
+)
+
+// Escape sequence keys.
+const (
+	KeyUnknown Key = -(iota + 1)
+	KeyUp
+	KeyDown
+	KeyRight
+	KeyLeft
+	KeyDelete
+)
+
+// KeyReader decodes keys from raw terminal input.
+type KeyReader struct {
+	r *bufio.Reader
+}
+
+// NewKeyReader creates key reader of raw input.
+func NewKeyReader(r io.Reader) *KeyReader {
+	return &KeyReader{r: bufio.NewReader(r)}
+}
+
+// ReadKey return next key, escape key is reported when no sequence follows it
+// in the same read.
+func (kr *KeyReader) ReadKey() (Key, error) {
+	c, _, err := kr.r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+	if Key(c) != KeyEscape || kr.r.Buffered() == 0 {
+		return Key(c), nil
+	}
+	next, err := kr.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if next != '[' && next != 'O' {
+		kr.r.UnreadByte()
+		return KeyEscape, nil
+	}
+	var params []byte
+	for {
+		b, err := kr.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b >= 0x40 && b <= 0x7e {
+			return sequenceKey(string(params), b), nil
+		}
+		params = append(params, b)
+	}
+}
+
+// sequenceKey return key of escape sequence with parameters and final byte.
+func sequenceKey(params string, final byte) Key {
+	switch {
+	case final == 'A':
+		return KeyUp
+	case final == 'B':
+		return KeyDown
+	case final == 'C':
+		return KeyRight
+	case final == 'D':
+		return KeyLeft
+	case final == '~' && params == "3":
+		return KeyDelete
+	}
+	return KeyUnknown
+}
@@ -0,0 +1,49 @@
+package editor
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyReader_ReadKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Key
+	}{
+		{"printable", "Kq s", []Key{'K', 'q', ' ', 's'}},
+		{"unicode", "♞", []Key{'♞'}},
+		{"arrows", "\x1b[A\x1b[B\x1b[C\x1b[D", []Key{KeyUp, KeyDown, KeyRight, KeyLeft}},
+		{"application arrows", "\x1bOA\x1bOD", []Key{KeyUp, KeyLeft}},
+		{"delete", "\x1b[3~", []Key{KeyDelete}},
+		{"unknown sequence", "\x1b[1;5Hx", []Key{KeyUnknown, 'x'}},
+		{"lone escape", "\x1b", []Key{KeyEscape}},
+		{"escape before key", "\x1bk", []Key{KeyEscape, 'k'}},
+		{"control keys", "\x03\x13\x7f", []Key{KeyCtrlC, KeyCtrlS, KeyBackspace}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kr := NewKeyReader(strings.NewReader(tt.input))
+			var got []Key
+			for {
+				k, err := kr.ReadKey()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				assert.NoError(t, err)
+				got = append(got, k)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKeyReader_ReadKey_truncated(t *testing.T) {
+	kr := NewKeyReader(strings.NewReader("\x1b[1"))
+	_, err := kr.ReadKey()
+	assert.ErrorIs(t, err, io.EOF)
+}
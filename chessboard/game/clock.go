@@ -0,0 +1,52 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+)
+
+// Clock represent players remaining times by colors and increment added after their moves.
+type Clock struct {
+	Remaining [2]time.Duration
+	Increment time.Duration
+}
+
+// NewClock creates clock with same initial time of both players and increment per move.
+func NewClock(initial, increment time.Duration) *Clock {
+	return &Clock{Remaining: [2]time.Duration{initial, initial}, Increment: increment}
+}
+
+// Charge subtracts elapsed time from remaining time of player with color,
+// it reports false when the player ran out of time.
+func (c *Clock) Charge(color chess.Color, elapsed time.Duration) bool {
+	c.Remaining[color] -= elapsed
+	if c.Remaining[color] <= 0 {
+		c.Remaining[color] = 0
+		return false
+	}
+	return true
+}
+
+// Add adds increment to remaining time of player with color after move.
+func (c *Clock) Add(color chess.Color) {
+	c.Remaining[color] += c.Increment
+}
+
+// String return remaining times of white and black players.
+func (c *Clock) String() string {
+	return fmt.Sprintf("white %s black %s", formatDuration(c.Remaining[chess.White]), formatDuration(c.Remaining[chess.Black]))
+}
+
+// formatDuration return duration like 1:05:00 or 4:59, tenths of seconds are shown below ten seconds.
+func formatDuration(d time.Duration) string {
+	if d < 10*time.Second {
+		return fmt.Sprintf("0:%02d.%d", d/time.Second, d%time.Second/(100*time.Millisecond))
+	}
+	s := int(d / time.Second)
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
@@ -0,0 +1,39 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock(t *testing.T) {
+	c := NewClock(time.Minute, 2*time.Second)
+	assert.True(t, c.Charge(chess.White, 10*time.Second))
+	c.Add(chess.White)
+	assert.Equal(t, [2]time.Duration{52 * time.Second, time.Minute}, c.Remaining)
+	assert.Equal(t, "white 0:52 black 1:00", c.String())
+
+	assert.False(t, c.Charge(chess.Black, 2*time.Minute))
+	assert.Equal(t, time.Duration(0), c.Remaining[chess.Black])
+}
+
+func Test_formatDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{"hours", time.Hour + 5*time.Minute + 3*time.Second, "1:05:03"},
+		{"minutes", 4*time.Minute + 59*time.Second + 900*time.Millisecond, "4:59"},
+		{"ten seconds", 10 * time.Second, "0:10"},
+		{"tenths", 9*time.Second + 870*time.Millisecond, "0:09.8"},
+		{"zero", 0, "0:00.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatDuration(tt.d))
+		})
+	}
+}
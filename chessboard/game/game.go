@@ -0,0 +1,137 @@
+// Package game plays two-player chess games with clocks and draw rules.
+package game
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+)
+
+var (
+	// ErrGameOver indicates that a move is played in finished game.
+	ErrGameOver = errors.New("game is over")
+	// ErrTimeout indicates that a player ran out of time before move.
+	ErrTimeout = errors.New("time is out")
+)
+
+// Reason represent why game ended.
+type Reason string
+
+// Game end reasons.
+const (
+	Checkmate           Reason = "checkmate"
+	Stalemate           Reason = "stalemate"
+	ThreefoldRepetition Reason = "threefold repetition"
+	FiftyMoveRule       Reason = "fifty-move rule"
+	Timeout             Reason = "timeout"
+)
+
+// Results in Portable Game Notation.
+const (
+	WhiteWins = "1-0"
+	BlackWins = "0-1"
+	Draw      = "1/2-1/2"
+)
+
+// Outcome represent result of finished game and reason it ended.
+type Outcome struct {
+	Result string
+	Reason Reason
+}
+
+// String return outcome like 1-0 checkmate.
+func (o Outcome) String() string {
+	return fmt.Sprintf("%s %s", o.Result, o.Reason)
+}
+
+// Game represent two-player game state.
+type Game struct {
+	Position *chess.Position
+	Moves    []chess.Move
+	// Clock is nil for game without time control.
+	Clock *Clock
+	// Outcome is nil while game goes on.
+	Outcome *Outcome
+
+	repetitions map[string]int
+}
+
+// New creates game from position with clock, nil clock for game without time control.
+func New(p *chess.Position, clock *Clock) *Game {
+	g := &Game{Position: p, Clock: clock, repetitions: make(map[string]int)}
+	g.repetitions[p.RepetitionKey()]++
+	g.Outcome = g.outcome()
+	return g
+}
+
+// Play charges elapsed time to side to move and plays its move written in coordinate
+// or Standard Algebraic Notation, game ends when the move finishes it.
+// Time is charged for illegal moves too, as it runs while player thinks.
+func (g *Game) Play(text string, elapsed time.Duration) (chess.Move, error) {
+	if g.Outcome != nil {
+		return chess.Move{}, ErrGameOver
+	}
+	turn := g.Position.Turn
+	if g.Clock != nil && !g.Clock.Charge(turn, elapsed) {
+		g.Outcome = &Outcome{Result: winner(turn.Other()), Reason: Timeout}
+		if !hasMatingMaterial(g.Position, turn.Other()) {
+			g.Outcome.Result = Draw
+		}
+		return chess.Move{}, fmt.Errorf("%s:%w", turn, ErrTimeout)
+	}
+	m, err := g.Position.ParseMoveText(text)
+	if err != nil {
+		return chess.Move{}, err
+	}
+	if g.Clock != nil {
+		g.Clock.Add(turn)
+	}
+	g.Position = g.Position.Play(m)
+	g.Moves = append(g.Moves, m)
+	g.repetitions[g.Position.RepetitionKey()]++
+	g.Outcome = g.outcome()
+	return m, nil
+}
+
+// outcome return outcome of current position or nil when game goes on.
+func (g *Game) outcome() *Outcome {
+	p := g.Position
+	switch {
+	case p.IsCheckmate():
+		return &Outcome{Result: winner(p.Turn.Other()), Reason: Checkmate}
+	case p.IsStalemate():
+		return &Outcome{Result: Draw, Reason: Stalemate}
+	case g.repetitions[p.RepetitionKey()] >= 3:
+		return &Outcome{Result: Draw, Reason: ThreefoldRepetition}
+	case p.HalfMoveClock >= chess.FiftyMoveLimit:
+		return &Outcome{Result: Draw, Reason: FiftyMoveRule}
+	}
+	return nil
+}
+
+func winner(c chess.Color) string {
+	if c == chess.White {
+		return WhiteWins
+	}
+	return BlackWins
+}
+
+// hasMatingMaterial reports whether pieces of color could checkmate,
+// lone king or king with single minor piece can not.
+func hasMatingMaterial(p *chess.Position, c chess.Color) bool {
+	minors := 0
+	for _, pc := range p.Squares {
+		if pc.Color != c {
+			continue
+		}
+		switch pc.Type {
+		case chess.Pawn, chess.Rook, chess.Queen:
+			return true
+		case chess.Knight, chess.Bishop:
+			minors++
+		}
+	}
+	return minors >= 2
+}
@@ -0,0 +1,129 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGame_Play(t *testing.T) {
+	tests := []struct {
+		name        string
+		fen         string
+		moves       string
+		wantOutcome *Outcome
+		wantFEN     string
+	}{
+		{
+			"fool's mate", chess.StartFEN, "f3 e7e5 g4 Qh4#",
+			&Outcome{BlackWins, Checkmate},
+			"rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3",
+		},
+		{
+			"stalemate", "7k/8/8/6K1/8/8/8/5Q2 w - - 0 1", "Qf7",
+			&Outcome{Draw, Stalemate},
+			"7k/5Q2/8/6K1/8/8/8/8 b - - 1 1",
+		},
+		{
+			"threefold repetition", chess.StartFEN, "Nf3 Nf6 Ng1 Ng8 Nf3 Nf6 Ng1 Ng8",
+			&Outcome{Draw, ThreefoldRepetition},
+			"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 8 5",
+		},
+		{
+			"fifty-move rule", "4k3/8/8/8/8/8/8/R3K3 w - - 98 60", "Ra2 Kd8",
+			&Outcome{Draw, FiftyMoveRule},
+			"3k4/8/8/8/8/8/R7/4K3 w - - 100 61",
+		},
+		{
+			"checkmate on fiftieth move", "7k/8/6K1/8/8/8/8/R7 w - - 99 60", "Ra8#",
+			&Outcome{WhiteWins, Checkmate},
+			"R6k/8/6K1/8/8/8/8/8 b - - 100 60",
+		},
+		{
+			"game goes on", chess.StartFEN, "e4 e5",
+			nil,
+			"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := chess.ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			g := New(p, nil)
+			for _, m := range strings.Fields(tt.moves) {
+				_, err := g.Play(m, time.Second)
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOutcome, g.Outcome)
+			assert.Equal(t, tt.wantFEN, g.Position.FEN())
+		})
+	}
+}
+
+func TestGame_Play_errors(t *testing.T) {
+	g := New(chess.NewPosition(), nil)
+	_, err := g.Play("e5", 0)
+	assert.ErrorIs(t, err, chess.ErrIllegalMove)
+	assert.Empty(t, g.Moves)
+
+	m, err := g.Play("e2e4", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "e2e4", m.String())
+	assert.Equal(t, []chess.Move{m}, g.Moves)
+
+	finished := New(chess.NewPosition(), nil)
+	finished.Outcome = &Outcome{WhiteWins, Checkmate}
+	_, err = finished.Play("e4", 0)
+	assert.ErrorIs(t, err, ErrGameOver)
+}
+
+func TestGame_Play_clock(t *testing.T) {
+	g := New(chess.NewPosition(), NewClock(time.Minute, 5*time.Second))
+
+	_, err := g.Play("e4", 10*time.Second)
+	assert.NoError(t, err)
+	_, err = g.Play("e4", 20*time.Second)
+	assert.ErrorIs(t, err, chess.ErrIllegalMove)
+	_, err = g.Play("e5", 10*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, [2]time.Duration{55 * time.Second, 35 * time.Second}, g.Clock.Remaining)
+
+	_, err = g.Play("Nf3", time.Minute)
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, &Outcome{BlackWins, Timeout}, g.Outcome)
+	assert.Len(t, g.Moves, 2)
+}
+
+func TestGame_Play_timeoutWithoutMatingMaterial(t *testing.T) {
+	tests := []struct {
+		name string
+		fen  string
+		want string
+	}{
+		{"lone king", "4k3/8/8/8/8/8/8/R3K3 w - -", Draw},
+		{"single knight", "4k1n1/8/8/8/8/8/8/R3K3 w - -", Draw},
+		{"two bishops", "2b1kb2/8/8/8/8/8/8/4K3 w - -", BlackWins},
+		{"pawn", "4k3/4p3/8/8/8/8/8/4K3 w - -", BlackWins},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := chess.ParseFEN(tt.fen)
+			assert.NoError(t, err)
+			g := New(p, NewClock(time.Second, 0))
+			_, err = g.Play("Ke2", 2*time.Second)
+			assert.ErrorIs(t, err, ErrTimeout)
+			assert.Equal(t, &Outcome{tt.want, Timeout}, g.Outcome)
+		})
+	}
+}
+
+func TestNew_finished(t *testing.T) {
+	p, err := chess.ParseFEN("7k/5Q2/6K1/8/8/8/8/8 b - -")
+	assert.NoError(t, err)
+	g := New(p, nil)
+	assert.Equal(t, &Outcome{Draw, Stalemate}, g.Outcome)
+	assert.Equal(t, "1/2-1/2 stalemate", g.Outcome.String())
+}
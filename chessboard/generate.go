@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/igkostyuk/dp210/chessboard/generate"
+)
+
+var (
+	// ErrCount indicates that a number of generated positions is not positive.
+	ErrCount = errors.New("count should be a positive integer")
+)
+
+// GenerateParameters represent generate command parameters.
+type GenerateParameters struct {
+	// Seed is a random source seed, zero for seed of current time.
+	Seed     int64
+	Material string
+	Mate     bool
+	Count    int
+	Answers  bool
+	Unicode  bool
+}
+
+func parseGenerateParameters(args []string) (*GenerateParameters, error) {
+	p := &GenerateParameters{}
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Int64Var(&p.Seed, "seed", 0, "random source seed")
+	fs.StringVar(&p.Material, "material", "KQRkr", "pieces as FEN letters")
+	fs.BoolVar(&p.Mate, "mate", false, "generate mate in one puzzles")
+	fs.IntVar(&p.Count, "count", 1, "number of positions")
+	fs.BoolVar(&p.Answers, "answers", false, "print puzzle solutions after positions")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw pieces with unicode glyphs")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if fs.NArg() != 0 {
+		return nil, ErrParameters
+	}
+	if p.Count <= 0 {
+		return nil, fmt.Errorf("parse param count: %w", ErrCount)
+	}
+	if p.Answers && !p.Mate {
+		return nil, fmt.Errorf("answers without mate flag:%w", ErrParameters)
+	}
+
+	return p, nil
+}
+
+func runGenerate(_ io.Reader, w io.Writer, args []string) error {
+	p, err := parseGenerateParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing generate parameters:%w", err)
+	}
+	if p.Seed == 0 {
+		p.Seed = time.Now().UnixNano()
+	}
+	return Generate(w, p)
+}
+
+// Generate write random positions or mate in one puzzles with their FEN
+// and generate parameters seed, so that they can be generated again.
+func Generate(w io.Writer, p *GenerateParameters) error {
+	material, err := generate.ParseMaterial(p.Material)
+	if err != nil {
+		return fmt.Errorf("generate material:%w", err)
+	}
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	rng := rand.New(rand.NewSource(p.Seed))
+	fmt.Fprintf(w, "seed: %d\n", p.Seed)
+
+	var answers []chess.Move
+	for i := 1; i <= p.Count; i++ {
+		var pos *chess.Position
+		task := ""
+		if p.Mate {
+			var m chess.Move
+			pos, m, err = generate.MateInOne(rng, material)
+			answers, task = append(answers, m), ", mate in one"
+		} else {
+			pos, err = generate.Position(rng, material)
+		}
+		if err != nil {
+			return fmt.Errorf("generate position %d:%w", i, err)
+		}
+		b, err := pos.Board(pieces)
+		if err != nil {
+			return fmt.Errorf("generate creating board:%w", err)
+		}
+		fmt.Fprintf(w, "\n%d. %s to move%s\n", i, pos.Turn, task)
+		if err := b.Write(w); err != nil {
+			return fmt.Errorf("generate writing board:%w", err)
+		}
+		fmt.Fprintf(w, "fen: %s\n", pos.FEN())
+	}
+	if p.Answers {
+		fmt.Fprintln(w, "\nanswers:")
+		for i, m := range answers {
+			fmt.Fprintf(w, "%d. %s\n", i+1, m)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Package generate creates random legal chess positions and mate in one puzzles
+// with seeded random sources, so that generated worksheets are reproducible.
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+)
+
+// Attempts is a number of random placements tried before giving up.
+const Attempts = 100000
+
+var (
+	// ErrMaterial indicates that a value is not a material set of one king per side and other pieces.
+	ErrMaterial = errors.New("material should be FEN piece letters with one king of each color")
+	// ErrAttempts indicates that a position was not generated within attempts.
+	ErrAttempts = errors.New("position was not generated within attempts")
+)
+
+// ParseMaterial parse material set written as FEN piece letters like KQRkr.
+func ParseMaterial(s string) ([]chess.Piece, error) {
+	var material []chess.Piece
+	kings, pawns := [2]int{}, [2]int{}
+	for _, l := range s {
+		pc, ok := chess.PieceFromLetter(l)
+		if !ok {
+			return nil, fmt.Errorf("parse material piece %q:%w", l, ErrMaterial)
+		}
+		switch pc.Type {
+		case chess.King:
+			kings[pc.Color]++
+		case chess.Pawn:
+			pawns[pc.Color]++
+		}
+		material = append(material, pc)
+	}
+	if kings != [2]int{1, 1} || pawns[chess.White] > 8 || pawns[chess.Black] > 8 || len(material) > 64 {
+		return nil, fmt.Errorf("parse material %q:%w", s, ErrMaterial)
+	}
+	return material, nil
+}
+
+// Position return random legal position with material and random side to move,
+// pawns are never placed on first and last ranks.
+func Position(rng *rand.Rand, material []chess.Piece) (*chess.Position, error) {
+	for i := 0; i < Attempts; i++ {
+		if p, ok := place(rng, material); ok {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("random position:%w", ErrAttempts)
+}
+
+// MateInOne return random legal position with material where side to move is not in check
+// and has exactly one move giving checkmate, along with the move.
+func MateInOne(rng *rand.Rand, material []chess.Piece) (*chess.Position, chess.Move, error) {
+	for i := 0; i < Attempts; i++ {
+		p, ok := place(rng, material)
+		if !ok || p.InCheck(p.Turn) {
+			continue
+		}
+		if m, ok := uniqueMate(p); ok {
+			return p, m, nil
+		}
+	}
+	return nil, chess.Move{}, fmt.Errorf("mate in one puzzle:%w", ErrAttempts)
+}
+
+// place puts material on random squares, pawns first so that they get squares
+// off first and last ranks, it reports false when position is not legal.
+func place(rng *rand.Rand, material []chess.Piece) (*chess.Position, bool) {
+	p := &chess.Position{EnPassant: chess.NoSquare, FullMoveNumber: 1, Turn: chess.Color(rng.Intn(2))}
+	squares := rng.Perm(64)
+	used := [64]bool{}
+	for _, pawns := range []bool{true, false} {
+		for _, pc := range material {
+			if (pc.Type == chess.Pawn) != pawns {
+				continue
+			}
+			for _, s := range squares {
+				sq := chess.Square(s)
+				if !used[sq] && (!pawns || sq.Rank() > 0 && sq.Rank() < 7) {
+					p.Squares[sq], used[sq] = pc, true
+					break
+				}
+			}
+		}
+	}
+	// parsing position FEN validates kings and checks.
+	valid, err := chess.ParseFEN(p.FEN())
+	if err != nil {
+		return nil, false
+	}
+	return valid, true
+}
+
+// uniqueMate return the only move of position giving checkmate.
+func uniqueMate(p *chess.Position) (chess.Move, bool) {
+	var mate chess.Move
+	found := 0
+	for _, m := range p.LegalMoves() {
+		if p.Play(m).IsCheckmate() {
+			mate = m
+			if found++; found > 1 {
+				return chess.Move{}, false
+			}
+		}
+	}
+	return mate, found == 1
+}
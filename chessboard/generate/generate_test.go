@@ -0,0 +1,100 @@
+package generate
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMaterial(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		want      []chess.Piece
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"kings and rook", "KRk",
+			[]chess.Piece{{Type: chess.King, Color: chess.White}, {Type: chess.Rook, Color: chess.White}, {Type: chess.King, Color: chess.Black}},
+			assert.NoError,
+		},
+		{"missing black king", "KQ", nil, assert.Error},
+		{"two white kings", "KKk", nil, assert.Error},
+		{"unknown letter", "KXk", nil, assert.Error},
+		{"nine pawns", "KPPPPPPPPPk", nil, assert.Error},
+		{"empty", "", nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMaterial(tt.s)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPosition(t *testing.T) {
+	material, err := ParseMaterial("KQRBNPPPkqrbnppp")
+	assert.NoError(t, err)
+	for seed := int64(0); seed < 50; seed++ {
+		p, err := Position(rand.New(rand.NewSource(seed)), material)
+		assert.NoError(t, err)
+		_, err = chess.ParseFEN(p.FEN())
+		assert.NoError(t, err)
+
+		var letters []rune
+		for sq, pc := range p.Squares {
+			if pc == chess.NoPiece {
+				continue
+			}
+			letters = append(letters, pc.Letter())
+			if r := chess.Square(sq).Rank(); pc.Type == chess.Pawn {
+				assert.True(t, r > 0 && r < 7, "pawn on rank %d", r+1)
+			}
+		}
+		sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+		assert.Equal(t, "BKNPPPQRbknpppqr", string(letters))
+	}
+}
+
+func TestPosition_seed(t *testing.T) {
+	material, err := ParseMaterial("KRRkq")
+	assert.NoError(t, err)
+	first, err := Position(rand.New(rand.NewSource(7)), material)
+	assert.NoError(t, err)
+	second, err := Position(rand.New(rand.NewSource(7)), material)
+	assert.NoError(t, err)
+	assert.Equal(t, first.FEN(), second.FEN())
+
+	other, err := Position(rand.New(rand.NewSource(8)), material)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.FEN(), other.FEN())
+}
+
+func TestMateInOne(t *testing.T) {
+	material, err := ParseMaterial("KQk")
+	assert.NoError(t, err)
+	for seed := int64(0); seed < 10; seed++ {
+		p, m, err := MateInOne(rand.New(rand.NewSource(seed)), material)
+		assert.NoError(t, err)
+		assert.False(t, p.InCheck(p.Turn))
+		assert.True(t, p.Play(m).IsCheckmate())
+		mates := 0
+		for _, lm := range p.LegalMoves() {
+			if p.Play(lm).IsCheckmate() {
+				mates++
+			}
+		}
+		assert.Equal(t, 1, mates)
+	}
+}
+
+func TestMateInOne_impossible(t *testing.T) {
+	material, err := ParseMaterial("KNk")
+	assert.NoError(t, err)
+	_, _, err = MateInOne(rand.New(rand.NewSource(1)), material)
+	assert.ErrorIs(t, err, ErrAttempts)
+}
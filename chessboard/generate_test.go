@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseGenerateParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *GenerateParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"defaults", args{nil}, &GenerateParameters{Material: "KQRkr", Count: 1}, assert.NoError},
+		{
+			"puzzles", args{[]string{"-seed", "42", "-material", "KQk", "-mate", "-count", "10", "-answers", "-unicode"}},
+			&GenerateParameters{Seed: 42, Material: "KQk", Mate: true, Count: 10, Answers: true, Unicode: true},
+			assert.NoError,
+		},
+		{"zero count", args{[]string{"-count", "0"}}, nil, assert.Error},
+		{"answers without mate", args{[]string{"-answers"}}, nil, assert.Error},
+		{"extra argument", args{[]string{"8"}}, nil, assert.Error},
+		{"invalid seed", args{[]string{"-seed", "x"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGenerateParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	type args struct {
+		p *GenerateParameters
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"positions",
+			args{&GenerateParameters{Seed: 3, Material: "KRPkp", Count: 2}},
+			"seed: 3\n\n" +
+				"1. white to move\n" +
+				" * * * K\n* * * * \n * * * *\n* *P* * \n * k * *\n*p* * * \nR* * * *\n* * * * \n" +
+				"fen: 7K/8/8/3P4/3k4/1p6/R7/8 w - - 0 1\n\n" +
+				"2. black to move\n" +
+				" * * * K\n* * * * \n * * * *\n* * * * \np* * * *\n* * k * \n * *P* *\n* *R* * \n" +
+				"fen: 7K/8/8/8/p7/4k3/4P3/3R4 b - - 0 1\n",
+			assert.NoError,
+		},
+		{
+			"mate in one with answers",
+			args{&GenerateParameters{Seed: 3, Material: "KQk", Mate: true, Count: 1, Answers: true}},
+			"seed: 3\n\n" +
+				"1. white to move, mate in one\n" +
+				" * * * *\n* * * * \n * * * *\n* * Q * \n * * * *\n* K * * \n * * * *\n* k * * \n" +
+				"fen: 8/8/8/4Q3/8/2K5/8/2k5 w - - 0 1\n\n" +
+				"answers:\n" +
+				"1. e5e1\n",
+			assert.NoError,
+		},
+		{
+			"invalid material",
+			args{&GenerateParameters{Seed: 3, Material: "KQ", Count: 1}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Generate(w, tt.args.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/igkostyuk/dp210/chessboard/board"
 )
@@ -14,56 +17,465 @@ var (
 
 	// ErrParameters indicates that program called with wrong number of parameters
 	ErrParameters = errors.New("should be 2 parameters <height> <width>")
+	// ErrFormat indicates that program called with unknown output format.
+	ErrFormat = errors.New("format should be one of text, svg, png, json, csv")
+	// ErrSymbol indicates that a square symbol is empty or has invalid escape sequence.
+	ErrSymbol = errors.New("symbol should be non-empty text with valid escape sequences")
+	// ErrStart indicates that program called with unknown top left square color.
+	ErrStart = errors.New("start color should be dark or light")
 )
 
 // Parameters represent task parameters.
 type Parameters struct {
-	Width  int
-	Height int
+	Width   int
+	Height  int
+	FEN     string
+	Unicode bool
+	Format  string
+	// SquareSize is an image square size in pixels, zero for default size.
+	SquareSize  int
+	Border      int
+	Coordinates bool
+	Frame       bool
+	Labels      bool
+	// Color is a terminal color mode, empty for none.
+	Color string
+	// CellHeight and CellWidth are text square sizes, zero for one character.
+	CellHeight int
+	CellWidth  int
+	// Input is a name of text board file to render, - for standard input.
+	Input string
+	// Pieces reads FEN letters and chess glyphs of input board as pieces.
+	Pieces bool
+	// AnySymbols reads input board squares with any symbols like squares drawn by editor.
+	AnySymbols bool
+	// Pattern is a name of squares pattern with optional block size like checker:2.
+	Pattern string
+	// Symbols are symbols of pattern colors, empty for black and white symbols.
+	Symbols symbolsFlag
+	// Transforms are board transformations applied in order before writing.
+	Transforms transformsFlag
+	// Black and White are square symbols, empty for default symbols.
+	Black string
+	White string
+	// Start is a color of top left square dark or light, empty for dark.
+	Start string
+	// Output is a name of file board is written to, empty for standard output.
+	Output string
+}
+
+// symbolsFlag collects values of repeated flag.
+type symbolsFlag []string
+
+func (s *symbolsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *symbolsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
 }
 
 func parseParameters(args []string) (*Parameters, error) {
+	p := &Parameters{}
+	fs := flag.NewFlagSet("chessboard", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.FEN, "fen", "", "position in Forsyth–Edwards Notation")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw pieces with unicode glyphs")
+	fs.StringVar(&p.Format, "format", "", "output format text, svg, png, json or csv")
+	fs.IntVar(&p.SquareSize, "square", 0, "image square size in pixels")
+	fs.IntVar(&p.Border, "border", 0, "image border width in pixels")
+	fs.BoolVar(&p.Coordinates, "coords", false, "draw image coordinates")
+	fs.BoolVar(&p.Frame, "frame", false, "draw text frame")
+	fs.BoolVar(&p.Labels, "labels", false, "draw text rank and file labels")
+	fs.StringVar(&p.Color, "color", "", "paint text squares auto, none, 256 or truecolor, none by default")
+	cell := fs.String("cell", "", "text square size <height>x<width>")
+	fs.StringVar(&p.Input, "input", "", "text board file to render, - for standard input")
+	fs.BoolVar(&p.Pieces, "pieces", false, "read FEN letters and chess glyphs of input board as pieces")
+	fs.BoolVar(&p.AnySymbols, "any", false, "read input board squares with any symbols")
+	fs.StringVar(&p.Pattern, "pattern", "", "squares pattern "+strings.Join(board.PatternNames(), ", ")+" with optional :<size>")
+	fs.Var(&p.Symbols, "symbol", "symbol of next pattern color, can be repeated")
+	fs.Func("rotate", "rotate board clockwise by degrees", p.Transforms.add("rotate"))
+	fs.Func("mirror", "mirror board h left to right or v top to bottom", p.Transforms.add("mirror"))
+	fs.Var(p.Transforms.addBool("transpose"), "transpose", "mirror board over its diagonal")
+	fs.Func("crop", "crop board to window between corner squares like b2:e5", p.Transforms.add("crop"))
+	fs.StringVar(&p.Black, "black", "", "black square symbol, escape sequences like \\u2588 are decoded")
+	fs.StringVar(&p.White, "white", "", "white square symbol, escape sequences like \\t are decoded")
+	fs.StringVar(&p.Start, "start", "", "color of top left square dark or light")
+	fs.StringVar(&p.Output, "o", "", "output file")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if err := decodeSymbols(fs, p); err != nil {
+		return nil, err
+	}
+	switch p.Start {
+	case "", "dark", "light":
+	default:
+		return nil, fmt.Errorf("parse param start %q: %w", p.Start, ErrStart)
+	}
+	switch p.Format {
+	case "", "text", "svg", "png", "json", "csv":
+	default:
+		return nil, fmt.Errorf("parse param format %q: %w", p.Format, ErrFormat)
+	}
+	if !validColorMode(p.Color) {
+		return nil, fmt.Errorf("parse param color %q: %w", p.Color, ErrColorMode)
+	}
+	if p.SquareSize < 0 || p.Border < 0 {
+		return nil, fmt.Errorf("parse param image sizes: %w", board.ErrSize)
+	}
+	if *cell != "" {
+		height, width, err := parseCell(*cell)
+		if err != nil {
+			return nil, fmt.Errorf("parse param cell %q: %w", *cell, err)
+		}
+		p.CellHeight, p.CellWidth = height, width
+	}
+	if !validColorLayout(p) {
+		return nil, fmt.Errorf("parse param color %q: %w", p.Color, ErrColorLayout)
+	}
+	if p.FEN != "" && p.Input != "" {
+		return nil, fmt.Errorf("fen and input together:%w", ErrParameters)
+	}
+	if p.Pattern != "" {
+		if _, err := board.ParsePattern(p.Pattern); err != nil {
+			return nil, fmt.Errorf("parse param pattern:%w", err)
+		}
+	}
+	if (p.Pattern != "" || len(p.Symbols) > 0) && (p.FEN != "" || p.Input != "") {
+		return nil, fmt.Errorf("pattern with fen or input:%w", ErrParameters)
+	}
+	if p.Start == "light" && (p.FEN != "" || p.Input != "") {
+		return nil, fmt.Errorf("light start with fen or input:%w", ErrParameters)
+	}
+	if len(p.Symbols) > 0 && (p.Black != "" || p.White != "") {
+		return nil, fmt.Errorf("symbol with black or white:%w", ErrParameters)
+	}
+	args = fs.Args()
+	if (p.FEN != "" || p.Input != "") && len(args) == 0 {
+		return p, nil
+	}
+	height, width, err := parseSize(args)
+	if err != nil {
+		return nil, err
+	}
+	p.Width, p.Height = width, height
+
+	return p, nil
+}
+
+// decodeSymbols decodes escape sequences of symbols set by flags.
+func decodeSymbols(fs *flag.FlagSet, p *Parameters) error {
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		if err != nil {
+			return
+		}
+		switch f.Name {
+		case "black":
+			p.Black, err = decodeSymbol(p.Black)
+		case "white":
+			p.White, err = decodeSymbol(p.White)
+		default:
+			return
+		}
+		if err != nil {
+			err = fmt.Errorf("parse param %s: %w", f.Name, err)
+		}
+	})
+	for i := 0; i < len(p.Symbols) && err == nil; i++ {
+		if p.Symbols[i], err = decodeSymbol(p.Symbols[i]); err != nil {
+			err = fmt.Errorf("parse param symbol %d: %w", i+1, err)
+		}
+	}
+	return err
+}
+
+// decodeSymbol return symbol with Go escape sequences like \t, \x1b or \u2588 decoded.
+func decodeSymbol(s string) (string, error) {
+	var b strings.Builder
+	for rest := s; rest != ""; {
+		r, _, tail, err := strconv.UnquoteChar(rest, 0)
+		if err != nil {
+			return "", fmt.Errorf("%q:%w", s, ErrSymbol)
+		}
+		b.WriteRune(r)
+		rest = tail
+	}
+	if b.Len() == 0 {
+		return "", fmt.Errorf("%q:%w", s, ErrSymbol)
+	}
+	return b.String(), nil
+}
+
+// parseSize parses <height> <width> positional parameters.
+func parseSize(args []string) (int, int, error) {
 	if len(args) != 2 {
-		return nil, ErrParameters
+		return 0, 0, ErrParameters
 	}
 
 	height, err := strconv.Atoi(args[0])
 	if err != nil || height <= 0 {
-		return nil, fmt.Errorf("parse param height: %w", board.ErrSize)
+		return 0, 0, fmt.Errorf("parse param height: %w", board.ErrSize)
 	}
 
 	width, err := strconv.Atoi(args[1])
 	if err != nil || width <= 0 {
-		return nil, fmt.Errorf("parse param width: %w", board.ErrSize)
+		return 0, 0, fmt.Errorf("parse param width: %w", board.ErrSize)
+	}
+
+	return height, width, nil
+}
+
+// parseCell parses cell size written as <height>x<width>.
+func parseCell(s string) (int, int, error) {
+	parts := strings.Split(s, "x")
+	if len(parts) != 2 {
+		return 0, 0, board.ErrSize
+	}
+	height, err := strconv.Atoi(parts[0])
+	if err != nil || height <= 0 {
+		return 0, 0, board.ErrSize
+	}
+	width, err := strconv.Atoi(parts[1])
+	if err != nil || width <= 0 {
+		return 0, 0, board.ErrSize
 	}
+	return height, width, nil
+}
 
-	return &Parameters{Width: width, Height: height}, nil
+// commands maps subcommand names to their runners.
+var commands = map[string]func(r io.Reader, w io.Writer, args []string) error{
+	"edit":     runEdit,
+	"generate": runGenerate,
+	"perft":    runPerft,
+	"pgn":      runPGN,
+	"place":    runPlace,
+	"play":     runPlay,
+	"queens":   runQueens,
+	"serve":    runServe,
+	"tour":     runTour,
 }
 
-func run(w io.Writer, args []string) error {
+func run(r io.Reader, w io.Writer, args []string) error {
+	if len(args) > 0 {
+		if cmd, ok := commands[args[0]]; ok {
+			return cmd(r, w, args[1:])
+		}
+	}
 	p, err := parseParameters(args)
 	if err != nil {
 		return fmt.Errorf("parsing parameters:%w", err)
 	}
+	if p.Output == "" {
+		return draw(r, w, p)
+	}
+	return writeFile(p.Output, func(w io.Writer) error {
+		return draw(r, w, p)
+	})
+}
+
+// writeFile write file with write into temporary file of the same directory
+// renamed to name only when write succeeds, so failed write leaves no partial file.
+func writeFile(name string, write func(w io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return fmt.Errorf("creating output file:%w", err)
+	}
+	defer os.Remove(f.Name())
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return fmt.Errorf("output file mode:%w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing output file:%w", err)
+	}
+	if err := os.Rename(f.Name(), name); err != nil {
+		return fmt.Errorf("renaming output file:%w", err)
+	}
+	return nil
+}
+
+// draw write board of input or task parameters.
+func draw(r io.Reader, w io.Writer, p *Parameters) error {
+	if p.Input != "" {
+		b, err := readBoard(r, p)
+		if err != nil {
+			return fmt.Errorf("reading input board:%w", err)
+		}
+		return Render(w, b, p)
+	}
 	return Task(w, p)
 }
 
+// readBoard parses text board from input file or reader
+// and checks it has sizes of parameters when they are set.
+func readBoard(r io.Reader, p *Parameters) (*board.Board, error) {
+	if p.Input != "-" {
+		f, err := os.Open(p.Input)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	b, err := board.Parse(r, board.ParseOptions{Pieces: p.Pieces, AnySymbols: p.AnySymbols})
+	if err != nil {
+		return nil, err
+	}
+	if p.Height > 0 && (b.Height != p.Height || b.Width != p.Width) {
+		return nil, fmt.Errorf("board %dx%d, want %dx%d:%w", b.Height, b.Width, p.Height, p.Width, board.ErrSize)
+	}
+	return b, nil
+}
+
 // Task write board with task parameters.
 func Task(w io.Writer, p *Parameters) error {
-	b, err := board.NewBoard(p.Height, p.Width, board.BlackSymbol, board.WhiteSymbol)
+	pattern, symbols, err := boardPattern(p)
+	if err != nil {
+		return fmt.Errorf("task pattern:%w", err)
+	}
+	if p.FEN == "" && len(p.Transforms) == 0 && (p.Format == "" || p.Format == "text") && colorMode(p.Color, w, os.Getenv) == board.NoColor {
+		if err := board.WritePatternStream(w, p.Height, p.Width, pattern, symbols, textOptions(p)); err != nil {
+			return fmt.Errorf("task writing board:%w", err)
+		}
+		return nil
+	}
+
+	b, err := newBoard(p)
 	if err != nil {
 		return fmt.Errorf("task creating board:%w", err)
 	}
-	return b.Write(w)
+	return Render(w, b, p)
+}
+
+// Render write board in format of task parameters.
+func Render(w io.Writer, b *board.Board, p *Parameters) error {
+	b, err := transform(b, p.Transforms)
+	if err != nil {
+		return fmt.Errorf("transforming board:%w", err)
+	}
+	switch p.Format {
+	case "svg":
+		return b.WriteSVG(w, imageOptions(p))
+	case "png":
+		return b.WritePNG(w, imageOptions(p))
+	case "json":
+		return b.WriteJSON(w)
+	case "csv":
+		return b.WriteCSV(w)
+	}
+	if mode := colorMode(p.Color, w, os.Getenv); mode != board.NoColor {
+		if !validColorLayout(p) {
+			return fmt.Errorf("rendering %s colors:%w", p.Color, ErrColorLayout)
+		}
+		o := board.DefaultANSIOptions
+		o.Mode = mode
+		if p.CellWidth > 0 {
+			o.CellWidth = p.CellWidth
+		}
+		return b.WriteANSI(w, o)
+	}
+	return b.WriteText(w, textOptions(p))
+}
+
+func textOptions(p *Parameters) board.TextOptions {
+	return board.TextOptions{
+		Frame:      p.Frame,
+		Labels:     p.Labels,
+		CellHeight: p.CellHeight,
+		CellWidth:  p.CellWidth,
+	}
+}
+
+func imageOptions(p *Parameters) board.ImageOptions {
+	o := board.DefaultImageOptions
+	if p.SquareSize > 0 {
+		o.SquareSize = p.SquareSize
+	}
+	o.Border, o.Coordinates = p.Border, p.Coordinates
+	return o
+}
+
+// boardPattern return pattern and symbols of parameters, checker of black and white symbols by default.
+func boardPattern(p *Parameters) (board.Pattern, []string, error) {
+	pattern, symbols := board.Checker(1), []string(p.Symbols)
+	if p.Pattern != "" {
+		var err error
+		if pattern, err = board.ParsePattern(p.Pattern); err != nil {
+			return nil, nil, err
+		}
+	}
+	if p.Start == "light" {
+		pattern = board.Offset(pattern, 1)
+	}
+	if len(symbols) == 0 {
+		black, white := squareSymbols(p)
+		symbols = []string{black, white}
+	}
+	return pattern, symbols, nil
+}
+
+// squareSymbols return black and white symbols of parameters or default ones.
+func squareSymbols(p *Parameters) (string, string) {
+	black, white := board.BlackSymbol, board.WhiteSymbol
+	if p.Black != "" {
+		black = p.Black
+	}
+	if p.White != "" {
+		white = p.White
+	}
+	return black, white
+}
+
+func newBoard(p *Parameters) (*board.Board, error) {
+	black, white := squareSymbols(p)
+	if p.FEN == "" && p.Pattern == "" && len(p.Symbols) == 0 && p.Start != "light" {
+		return board.NewBoard(p.Height, p.Width, black, white)
+	}
+	if p.FEN == "" {
+		pattern, symbols, err := boardPattern(p)
+		if err != nil {
+			return nil, err
+		}
+		return board.NewPatternBoard(p.Height, p.Width, pattern, symbols)
+	}
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	return board.FromFEN(p.FEN, black, white, pieces)
 }
 
 func usage(w io.Writer) {
 	fmt.Fprintf(w, "%s: print chessboard\n", os.Args[0])
-	fmt.Fprintf(w, "usage: %s <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s [-frame] [-labels] [-cell <height>x<width>] <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s [-color auto|none|256|truecolor] [-cell 1x<width>] <board flags>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s [-black <symbol>] [-white <symbol>] [-start dark|light] [-o <file>] <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s -fen <fen> [-unicode]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s -input <file|-> [-pieces] [-any] [<height> <width>]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s -pattern <name>[:<size>] [-symbol <symbol>]... <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s [-rotate <degrees>] [-mirror h|v] [-transpose] [-crop <square>:<square>]... <board flags>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s -format svg|png [-square <px>] [-border <px>] [-coords] <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s -format json|csv <board flags>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s edit [-fen <fen> | -input <file>] [-unicode] [-format text|fen|json] -o <file> [<height> <width>]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s generate [-seed <n>] [-material <pieces>] [-count <n>] [-mate [-answers]] [-unicode]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s perft [-fen <fen>] [-divide] <depth>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s pgn [-unicode] [-final | -ply <ply>] [file]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s place [-samples <n>] [-unicode] <piece> <pieces> <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s play [-fen <fen>] [-unicode] [-time <duration> [-increment <duration>]] [file]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s queens [-n <queens>] [-unicode] [-all | -count [-progress]] <height> <width>\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s serve [-addr <host:port>] [-max <size>]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s tour [-start <square>] [-closed] [-frame] [-labels] <height> <width>\n", os.Args[0])
 }
 
 func main() {
-	if err := run(os.Stdout, os.Args[1:]); err != nil {
+	if err := run(os.Stdin, os.Stdout, os.Args[1:]); err != nil {
 		if errors.Is(err, ErrParameters) {
 			usage(os.Stdout)
 		}
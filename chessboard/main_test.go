@@ -2,10 +2,16 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"image"
+	"image/png"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/igkostyuk/dp210/chessboard/board"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,6 +49,120 @@ func Test_parseParameters(t *testing.T) {
 			"negative width parameter", args{[]string{"1", "-2"}},
 			nil, assert.Error,
 		},
+		{
+			"fen parameter", args{[]string{"-fen", "8/8/8/8/8/8/8/8", "-unicode"}},
+			&Parameters{FEN: "8/8/8/8/8/8/8/8", Unicode: true}, assert.NoError,
+		},
+		{
+			"input parameter", args{[]string{"-input", "-"}},
+			&Parameters{Input: "-"}, assert.NoError,
+		},
+		{
+			"input pieces parameter", args{[]string{"-input", "-", "-pieces"}},
+			&Parameters{Input: "-", Pieces: true}, assert.NoError,
+		},
+		{
+			"input any symbols parameter", args{[]string{"-input", "-", "-any"}},
+			&Parameters{Input: "-", AnySymbols: true}, assert.NoError,
+		},
+		{
+			"fen and input", args{[]string{"-fen", "2/2", "-input", "-"}},
+			nil, assert.Error,
+		},
+		{
+			"pattern parameters", args{[]string{"-pattern", "rings:2", "-symbol", "#", "-symbol", ".", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Pattern: "rings:2", Symbols: symbolsFlag{"#", "."}}, assert.NoError,
+		},
+		{
+			"symbol parameters", args{[]string{"-black", `\u2588`, "-white", "·", "-start", "light", "-o", "board.txt", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Black: "█", White: "·", Start: "light", Output: "board.txt"}, assert.NoError,
+		},
+		{
+			"escaped pattern symbols", args{[]string{"-symbol", `\t`, "-symbol", `\x41\\`, "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Symbols: symbolsFlag{"\t", "A\\"}}, assert.NoError,
+		},
+		{"empty black symbol", args{[]string{"-black", "", "1", "2"}}, nil, assert.Error},
+		{"invalid escape", args{[]string{"-white", `\q`, "1", "2"}}, nil, assert.Error},
+		{"invalid symbol escape", args{[]string{"-symbol", `\u12`, "1", "2"}}, nil, assert.Error},
+		{"unknown start", args{[]string{"-start", "grey", "1", "2"}}, nil, assert.Error},
+		{"light start with fen", args{[]string{"-start", "light", "-fen", "2/2"}}, nil, assert.Error},
+		{"symbol with black", args{[]string{"-symbol", "#", "-black", "*", "1", "2"}}, nil, assert.Error},
+		{
+			"unknown pattern", args{[]string{"-pattern", "waves", "1", "2"}},
+			nil, assert.Error,
+		},
+		{
+			"pattern with fen", args{[]string{"-pattern", "stripes", "-fen", "2/2"}},
+			nil, assert.Error,
+		},
+		{
+			"fen with sizes", args{[]string{"-fen", "2/2", "2", "2"}},
+			&Parameters{Height: 2, Width: 2, FEN: "2/2"}, assert.NoError,
+		},
+		{
+			"unknown flag", args{[]string{"-unknown", "1", "2"}},
+			nil, assert.Error,
+		},
+		{
+			"image parameters", args{[]string{"-format", "svg", "-square", "20", "-border", "3", "-coords", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Format: "svg", SquareSize: 20, Border: 3, Coordinates: true}, assert.NoError,
+		},
+		{
+			"text parameters", args{[]string{"-frame", "-labels", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Frame: true, Labels: true}, assert.NoError,
+		},
+		{
+			"color parameter", args{[]string{"-color", "auto", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Color: "auto"}, assert.NoError,
+		},
+		{
+			"unknown color", args{[]string{"-color", "rainbow", "1", "2"}},
+			nil, assert.Error,
+		},
+		{
+			"color with wide cells", args{[]string{"-color", "256", "-cell", "1x3", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Color: "256", CellHeight: 1, CellWidth: 3}, assert.NoError,
+		},
+		{
+			"no color with frame", args{[]string{"-color", "none", "-frame", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Color: "none", Frame: true}, assert.NoError,
+		},
+		{
+			"color with frame", args{[]string{"-color", "auto", "-frame", "1", "2"}},
+			nil, errorIs(ErrColorLayout),
+		},
+		{
+			"color with labels", args{[]string{"-color", "truecolor", "-labels", "1", "2"}},
+			nil, errorIs(ErrColorLayout),
+		},
+		{
+			"color with cell height", args{[]string{"-color", "256", "-cell", "2x2", "1", "2"}},
+			nil, errorIs(ErrColorLayout),
+		},
+		{
+			"json format", args{[]string{"-format", "json", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, Format: "json"}, assert.NoError,
+		},
+		{
+			"unknown format", args{[]string{"-format", "gif", "1", "2"}},
+			nil, assert.Error,
+		},
+		{
+			"negative square size", args{[]string{"-square", "-1", "1", "2"}},
+			nil, assert.Error,
+		},
+		{
+			"cell parameter", args{[]string{"-cell", "2x4", "1", "2"}},
+			&Parameters{Height: 1, Width: 2, CellHeight: 2, CellWidth: 4}, assert.NoError,
+		},
+		{
+			"invalid cell parameter", args{[]string{"-cell", "2", "1", "2"}},
+			nil, assert.Error,
+		},
+		{
+			"zero cell parameter", args{[]string{"-cell", "0x4", "1", "2"}},
+			nil, assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -65,16 +185,126 @@ func Test_run(t *testing.T) {
 	}{
 		{"valid params", args{[]string{"1", "1"}}, "*\n", assert.NoError},
 		{"invalid params", args{[]string{"invalid", "1"}}, "", assert.Error},
+		{"edit command", args{[]string{"edit", "2", "2"}}, "", assert.Error},
+		{"generate command", args{[]string{"generate", "-count", "0"}}, "", assert.Error},
+		{"perft command", args{[]string{"perft", "2"}}, "nodes: 400\n", assert.NoError},
+		{"pgn command", args{[]string{"pgn", "-ply", "0", "-unicode"}}, "", assert.Error},
+		{"place command", args{[]string{"place", "rook", "8", "8", "8"}}, "arrangements: 40320\n", assert.NoError},
+		{"play command", args{[]string{"play", "-time", "-1s"}}, "", assert.Error},
+		{"queens command", args{[]string{"queens", "-count", "6", "6"}}, "solutions: 4\n", assert.NoError},
+		{"serve command", args{[]string{"serve", "-max", "0"}}, "", errorIs(board.ErrSize)},
+		{"tour command", args{[]string{"tour", "2", "2"}}, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, run(strings.NewReader(""), w, tt.args.args))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+// errorIs return assertion that error wraps target.
+func errorIs(target error) assert.ErrorAssertionFunc {
+	return func(t assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+		return assert.ErrorIs(t, err, target, msgAndArgs...)
+	}
+}
+
+func Test_run_input(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "board.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("#.#\n.#.\n"), 0o600))
+
+	type args struct {
+		input string
+		args  []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"standard input", args{"k.\n.K\n", []string{"-input", "-", "-pieces", "-frame"}},
+			"┌──┐\n│k.│\n│.K│\n└──┘\n", assert.NoError,
+		},
+		{
+			"piece letters as symbols", args{"bn\nnb\n", []string{"-input", "-", "-format", "json"}},
+			`{"height":2,"width":2,"symbols":["b","n"],"colors":[[0,1],[1,0]]}` + "\n", assert.NoError,
+		},
+		{"pieces without pieces flag", args{"k.\n.K\n", []string{"-input", "-"}}, "", assert.Error},
+		{
+			"any symbols", args{"R*@\n* *\n", []string{"-input", "-", "-pieces", "-any"}},
+			"R*@\n* *\n", assert.NoError,
+		},
+		{"any symbols json", args{"R*@\n* *\n", []string{"-input", "-", "-pieces", "-any", "-format", "json"}}, "", assert.Error},
+		{
+			"file with sizes", args{"", []string{"-input", file, "-cell", "1x2", "2", "3"}},
+			"##..##\n..##..\n", assert.NoError,
+		},
+		{
+			"svg", args{"#\n", []string{"-input", "-", "-format", "svg", "-square", "10"}},
+			"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"10\" height=\"10\" viewBox=\"0 0 10 10\">\n" +
+				"<rect x=\"0\" y=\"0\" width=\"10\" height=\"10\" fill=\"#b58863\"/>\n" +
+				"</svg>\n", assert.NoError,
+		},
+		{"different sizes", args{"", []string{"-input", file, "3", "3"}}, "", assert.Error},
+		{"malformed board", args{"#.\n#.\n", []string{"-input", "-"}}, "", assert.Error},
+		{"missing file", args{"", []string{"-input", file + ".missing"}}, "", assert.Error},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			w := &bytes.Buffer{}
-			tt.assertion(t, run(w, tt.args.args))
+			tt.assertion(t, run(strings.NewReader(tt.args.input), w, tt.args.args))
 			assert.Equal(t, tt.wantW, w.String())
 		})
 	}
 }
 
+func Test_run_output(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "board.txt")
+	w := &bytes.Buffer{}
+	assert.NoError(t, run(strings.NewReader(""), w, []string{"-o", file, "-black", `\u2588`, "2", "2"}))
+	assert.Empty(t, w.String())
+	got, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "█ \n █\n", string(got))
+
+	err = run(strings.NewReader(""), w, []string{"-o", filepath.Join(file, "missing", "board.txt"), "2", "2"})
+	assert.Error(t, err)
+	err = run(strings.NewReader(""), w, []string{"-o", file, "-format", "csv", "-crop", "a1:c1", "2", "2"})
+	assert.Error(t, err)
+	got, err = os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "█ \n █\n", string(got))
+
+	failed := filepath.Join(filepath.Dir(file), "failed.txt")
+	err = run(strings.NewReader(""), w, []string{"-o", failed, "-fen", "x7"})
+	assert.Error(t, err)
+	assert.NoFileExists(t, failed)
+	entries, err := os.ReadDir(filepath.Dir(file))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func Test_run_symbolErrors(t *testing.T) {
+	err := run(strings.NewReader(""), &bytes.Buffer{}, []string{"-black", `\x`, "2", "2"})
+	assert.True(t, errors.Is(err, ErrSymbol))
+	err = run(strings.NewReader(""), &bytes.Buffer{}, []string{"-start", "grey", "2", "2"})
+	assert.True(t, errors.Is(err, ErrStart))
+	err = run(strings.NewReader(""), &bytes.Buffer{}, []string{"-black", "#", "2"})
+	assert.True(t, errors.Is(err, ErrParameters))
+	err = run(strings.NewReader(""), &bytes.Buffer{}, []string{"-black", "#", "2", "0"})
+	assert.True(t, errors.Is(err, board.ErrSize))
+}
+
+func Test_run_inputLine(t *testing.T) {
+	err := run(strings.NewReader("* *\n * \n***\n"), &bytes.Buffer{}, []string{"-input", "-"})
+	assert.True(t, errors.Is(err, board.ErrText))
+	assert.Contains(t, err.Error(), "line 3 column 2")
+}
+
 func TestTask(t *testing.T) {
 	type args struct {
 		p *Parameters
@@ -95,6 +325,121 @@ func TestTask(t *testing.T) {
 			args{&Parameters{Height: -3, Width: 3}},
 			"", assert.Error,
 		},
+		{
+			"fen ascii pieces",
+			args{&Parameters{FEN: "k2/3/2K"}},
+			"k *\n * \n* K\n", assert.NoError,
+		},
+		{
+			"fen unicode pieces",
+			args{&Parameters{FEN: "k2/3/2K", Unicode: true}},
+			"♚ *\n * \n* ♔\n", assert.NoError,
+		},
+		{
+			"invalid fen",
+			args{&Parameters{FEN: "k2/2"}},
+			"", assert.Error,
+		},
+		{
+			"frame and labels",
+			args{&Parameters{Height: 2, Width: 2, Frame: true, Labels: true}},
+			"  ┌──┐\n2 │* │\n1 │ *│\n  └──┘\n   ab\n", assert.NoError,
+		},
+		{
+			"cells",
+			args{&Parameters{Height: 2, Width: 2, CellHeight: 2, CellWidth: 4}},
+			"****    \n****    \n    ****\n    ****\n", assert.NoError,
+		},
+		{
+			"fen cells",
+			args{&Parameters{FEN: "k1/2", CellHeight: 1, CellWidth: 3}},
+			" k ***\n***   \n", assert.NoError,
+		},
+		{
+			"pattern",
+			args{&Parameters{Height: 3, Width: 3, Pattern: "rings", Symbols: symbolsFlag{"#", "."}}},
+			"###\n#.#\n###\n", assert.NoError,
+		},
+		{
+			"symbols only",
+			args{&Parameters{Height: 2, Width: 3, Symbols: symbolsFlag{"a", "b", "c"}}},
+			"abc\nbca\n", assert.NoError,
+		},
+		{
+			"pattern with frame",
+			args{&Parameters{Height: 2, Width: 2, Pattern: "stripes", Frame: true}},
+			"┌──┐\n│**│\n│  │\n└──┘\n", assert.NoError,
+		},
+		{
+			"pattern svg",
+			args{&Parameters{Height: 1, Width: 2, Pattern: "vstripes:2", Format: "svg", SquareSize: 10}},
+			"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"20\" height=\"10\" viewBox=\"0 0 20 10\">\n" +
+				"<rect x=\"0\" y=\"0\" width=\"10\" height=\"10\" fill=\"#b58863\"/>\n" +
+				"<rect x=\"10\" y=\"0\" width=\"10\" height=\"10\" fill=\"#b58863\"/>\n" +
+				"</svg>\n", assert.NoError,
+		},
+		{
+			"custom symbols",
+			args{&Parameters{Height: 2, Width: 3, Black: "██", White: "  "}},
+			"██  ██\n  ██  \n", assert.NoError,
+		},
+		{
+			"light start",
+			args{&Parameters{Height: 2, Width: 3, Black: "#", White: ".", Start: "light"}},
+			".#.\n#.#\n", assert.NoError,
+		},
+		{
+			"light start colors",
+			args{&Parameters{Height: 1, Width: 2, Start: "light", Format: "csv"}},
+			"rank,a,b\n1,1,0\n", assert.NoError,
+		},
+		{
+			"fen symbols",
+			args{&Parameters{FEN: "k1/2", Black: "#", White: "."}},
+			"k#\n#.\n", assert.NoError,
+		},
+		{
+			"json format",
+			args{&Parameters{Height: 1, Width: 2, Format: "json"}},
+			`{"height":1,"width":2,"symbols":["*"," "],"colors":[[0,1]]}` + "\n", assert.NoError,
+		},
+		{
+			"csv format",
+			args{&Parameters{Height: 2, Width: 2, Pattern: "stripes", Format: "csv"}},
+			"rank,a,b\n2,0,0\n1,1,1\n", assert.NoError,
+		},
+		{
+			"invalid pattern",
+			args{&Parameters{Height: 2, Width: 2, Pattern: "waves"}},
+			"", assert.Error,
+		},
+		{
+			"auto color is plain for buffer",
+			args{&Parameters{Height: 1, Width: 2, Color: "auto"}},
+			"* \n", assert.NoError,
+		},
+		{
+			"256 colors",
+			args{&Parameters{Height: 1, Width: 1, Color: "256"}},
+			"\x1b[48;5;180m  \x1b[0m\n", assert.NoError,
+		},
+		{
+			"256 colors wide cells",
+			args{&Parameters{Height: 1, Width: 1, Color: "256", CellWidth: 3}},
+			"\x1b[48;5;180m   \x1b[0m\n", assert.NoError,
+		},
+		{
+			"256 colors with labels",
+			args{&Parameters{Height: 1, Width: 1, Color: "256", Labels: true}},
+			"", errorIs(ErrColorLayout),
+		},
+		{
+			"svg format",
+			args{&Parameters{Height: 1, Width: 1, Format: "svg", SquareSize: 10}},
+			"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"10\" height=\"10\" viewBox=\"0 0 10 10\">\n" +
+				"<rect x=\"0\" y=\"0\" width=\"10\" height=\"10\" fill=\"#b58863\"/>\n" +
+				"</svg>\n", assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -105,6 +450,15 @@ func TestTask(t *testing.T) {
 	}
 }
 
+func TestTask_png(t *testing.T) {
+	w := &bytes.Buffer{}
+	assert.NoError(t, Task(w, &Parameters{Height: 2, Width: 3, Format: "png", Border: 2}))
+	img, err := png.Decode(w)
+	assert.NoError(t, err)
+	size := board.DefaultImageOptions.SquareSize
+	assert.Equal(t, image.Rect(0, 0, 3*size+4, 2*size+4), img.Bounds())
+}
+
 func Test_usage(t *testing.T) {
 	name := "test"
 	os.Args[0] = name
@@ -114,7 +468,26 @@ func Test_usage(t *testing.T) {
 	}{
 		{
 			"test name",
-			fmt.Sprintf("%s: print chessboard\nusage: %s <height> <width>\n", name, name),
+			fmt.Sprintf("%s: print chessboard\n"+
+				"usage: %s [-frame] [-labels] [-cell <height>x<width>] <height> <width>\n"+
+				"usage: %s [-color auto|none|256|truecolor] [-cell 1x<width>] <board flags>\n"+
+				"usage: %s [-black <symbol>] [-white <symbol>] [-start dark|light] [-o <file>] <height> <width>\n"+
+				"usage: %s -fen <fen> [-unicode]\n"+
+				"usage: %s -input <file|-> [-pieces] [-any] [<height> <width>]\n"+
+				"usage: %s -pattern <name>[:<size>] [-symbol <symbol>]... <height> <width>\n"+
+				"usage: %s [-rotate <degrees>] [-mirror h|v] [-transpose] [-crop <square>:<square>]... <board flags>\n"+
+				"usage: %s -format svg|png [-square <px>] [-border <px>] [-coords] <height> <width>\n"+
+				"usage: %s -format json|csv <board flags>\n"+
+				"usage: %s edit [-fen <fen> | -input <file>] [-unicode] [-format text|fen|json] -o <file> [<height> <width>]\n"+
+				"usage: %s generate [-seed <n>] [-material <pieces>] [-count <n>] [-mate [-answers]] [-unicode]\n"+
+				"usage: %s perft [-fen <fen>] [-divide] <depth>\n"+
+				"usage: %s pgn [-unicode] [-final | -ply <ply>] [file]\n"+
+				"usage: %s place [-samples <n>] [-unicode] <piece> <pieces> <height> <width>\n"+
+				"usage: %s play [-fen <fen>] [-unicode] [-time <duration> [-increment <duration>]] [file]\n"+
+				"usage: %s queens [-n <queens>] [-unicode] [-all | -count [-progress]] <height> <width>\n"+
+				"usage: %s serve [-addr <host:port>] [-max <size>]\n"+
+				"usage: %s tour [-start <square>] [-closed] [-frame] [-labels] <height> <width>\n",
+				name, name, name, name, name, name, name, name, name, name, name, name, name, name, name, name, name, name, name),
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+)
+
+var (
+	// ErrDepth indicates that a value does not have the right syntax for the depth type.
+	ErrDepth = errors.New("depth should be a non-negative integer")
+)
+
+// PerftParameters represent perft command parameters.
+type PerftParameters struct {
+	FEN    string
+	Depth  int
+	Divide bool
+}
+
+func parsePerftParameters(args []string) (*PerftParameters, error) {
+	p := &PerftParameters{}
+	fs := flag.NewFlagSet("perft", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.FEN, "fen", chess.StartFEN, "position in Forsyth–Edwards Notation")
+	fs.BoolVar(&p.Divide, "divide", false, "print nodes count for every move")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if fs.NArg() != 1 {
+		return nil, ErrParameters
+	}
+
+	depth, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || depth < 0 {
+		return nil, fmt.Errorf("parse param depth: %w", ErrDepth)
+	}
+	p.Depth = depth
+
+	return p, nil
+}
+
+func runPerft(_ io.Reader, w io.Writer, args []string) error {
+	p, err := parsePerftParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing perft parameters:%w", err)
+	}
+	return Perft(w, p)
+}
+
+// Perft write number of leaf nodes of legal moves tree with perft parameters.
+func Perft(w io.Writer, p *PerftParameters) error {
+	pos, err := chess.ParseFEN(p.FEN)
+	if err != nil {
+		return fmt.Errorf("perft parsing position:%w", err)
+	}
+	if !p.Divide || p.Depth == 0 {
+		fmt.Fprintf(w, "nodes: %d\n", pos.Perft(p.Depth))
+		return nil
+	}
+
+	divide := pos.Divide(p.Depth)
+	moves := make([]chess.Move, 0, len(divide))
+	for m := range divide {
+		moves = append(moves, m)
+	}
+	sort.Slice(moves, func(i, j int) bool { return moves[i].String() < moves[j].String() })
+	nodes := 0
+	for _, m := range moves {
+		fmt.Fprintf(w, "%s: %d\n", m, divide[m])
+		nodes += divide[m]
+	}
+	fmt.Fprintf(w, "nodes: %d\n", nodes)
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parsePerftParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *PerftParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"depth only", args{[]string{"3"}},
+			&PerftParameters{FEN: chess.StartFEN, Depth: 3}, assert.NoError,
+		},
+		{
+			"fen and divide", args{[]string{"-fen", "4k3/8/8/8/8/8/8/4K3 w - -", "-divide", "1"}},
+			&PerftParameters{FEN: "4k3/8/8/8/8/8/8/4K3 w - -", Depth: 1, Divide: true}, assert.NoError,
+		},
+		{"missing depth", args{[]string{}}, nil, assert.Error},
+		{"negative depth", args{[]string{"-1"}}, nil, assert.Error},
+		{"invalid depth", args{[]string{"INVALID"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown", "1"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePerftParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPerft(t *testing.T) {
+	type args struct {
+		p *PerftParameters
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"start position",
+			args{&PerftParameters{FEN: chess.StartFEN, Depth: 3}},
+			"nodes: 8902\n", assert.NoError,
+		},
+		{
+			"divide",
+			args{&PerftParameters{FEN: "7k/8/8/8/8/8/8/K7 w - -", Depth: 2, Divide: true}},
+			"a1a2: 3\na1b1: 3\na1b2: 3\nnodes: 9\n", assert.NoError,
+		},
+		{
+			"zero depth divide",
+			args{&PerftParameters{FEN: chess.StartFEN, Divide: true}},
+			"nodes: 1\n", assert.NoError,
+		},
+		{
+			"invalid fen",
+			args{&PerftParameters{FEN: "8/8 w - -", Depth: 1}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Perft(w, tt.args.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func Test_runPerft(t *testing.T) {
+	w := &bytes.Buffer{}
+	assert.Error(t, runPerft(nil, w, []string{"x"}))
+	assert.NoError(t, runPerft(nil, w, []string{"1"}))
+	assert.Equal(t, "nodes: 20\n", w.String())
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/chess"
+)
+
+var (
+	// ErrPly indicates that a ply is out of game moves range.
+	ErrPly = errors.New("ply should be between 0 and number of game moves")
+)
+
+// PGNParameters represent pgn command parameters.
+type PGNParameters struct {
+	File    string
+	Ply     int
+	Final   bool
+	Unicode bool
+}
+
+func parsePGNParameters(args []string) (*PGNParameters, error) {
+	p := &PGNParameters{}
+	fs := flag.NewFlagSet("pgn", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.IntVar(&p.Ply, "ply", -1, "print only position after ply")
+	fs.BoolVar(&p.Final, "final", false, "print only final position")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw pieces with unicode glyphs")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if fs.NArg() > 1 {
+		return nil, ErrParameters
+	}
+	ply := false
+	fs.Visit(func(f *flag.Flag) { ply = ply || f.Name == "ply" })
+	if p.Final && ply {
+		return nil, fmt.Errorf("final and ply together:%w", ErrParameters)
+	}
+	p.File = fs.Arg(0)
+
+	return p, nil
+}
+
+func runPGN(r io.Reader, w io.Writer, args []string) error {
+	p, err := parsePGNParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing pgn parameters:%w", err)
+	}
+	if p.File != "" {
+		f, err := os.Open(p.File)
+		if err != nil {
+			return fmt.Errorf("opening pgn file:%w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	return PGN(r, w, p)
+}
+
+// PGN replay game from reader and write board after moves with pgn parameters.
+func PGN(r io.Reader, w io.Writer, p *PGNParameters) error {
+	g, err := chess.ReadPGN(r)
+	if err != nil {
+		return fmt.Errorf("pgn reading game:%w", err)
+	}
+	positions, replayErr := g.Replay()
+	if len(positions) == 0 {
+		return fmt.Errorf("pgn replaying game:%w", replayErr)
+	}
+
+	from, to := 1, len(positions)-1
+	switch {
+	case p.Ply >= 0 && replayErr == nil && p.Ply > to:
+		return fmt.Errorf("ply %d:%w", p.Ply, ErrPly)
+	case p.Ply >= 0:
+		from, to = p.Ply, p.Ply
+	case p.Final:
+		from = to
+	}
+
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	for ply := from; ply <= to && ply < len(positions); ply++ {
+		if ply > from {
+			fmt.Fprintln(w)
+		}
+		if err := writePly(w, g, positions, ply, pieces); err != nil {
+			return err
+		}
+	}
+	if replayErr != nil {
+		return fmt.Errorf("pgn replaying game:%w", replayErr)
+	}
+	return nil
+}
+
+func writePly(w io.Writer, g *chess.Game, positions []*chess.Position, ply int, pieces board.PieceSet) error {
+	if ply == 0 {
+		fmt.Fprintln(w, "start")
+	} else {
+		prev := positions[ply-1]
+		fmt.Fprintf(w, "%d%s %s\n", prev.FullMoveNumber, chess.MoveNumberSuffix(prev.Turn), g.Moves[ply-1])
+	}
+	b, err := positions[ply].Board(pieces)
+	if err != nil {
+		return fmt.Errorf("pgn creating board:%w", err)
+	}
+	return b.Write(w)
+}
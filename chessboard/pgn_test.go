@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const foolsMate = "[Event \"Fool's mate\"]\n1. f3 e5 2. g4 Qh4# 0-1\n"
+
+func Test_parsePGNParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *PGNParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"defaults", args{[]string{}}, &PGNParameters{Ply: -1}, assert.NoError},
+		{
+			"all flags", args{[]string{"-unicode", "-ply", "2", "game.pgn"}},
+			&PGNParameters{File: "game.pgn", Ply: 2, Unicode: true}, assert.NoError,
+		},
+		{"final", args{[]string{"-final"}}, &PGNParameters{Ply: -1, Final: true}, assert.NoError},
+		{"final and ply", args{[]string{"-final", "-ply", "2"}}, nil, errorIs(ErrParameters)},
+		{"final and default ply", args{[]string{"-final", "-ply", "-1"}}, nil, errorIs(ErrParameters)},
+		{"too many files", args{[]string{"a.pgn", "b.pgn"}}, nil, assert.Error},
+		{"invalid ply", args{[]string{"-ply", "x"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePGNParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPGN(t *testing.T) {
+	type args struct {
+		r io.Reader
+		p *PGNParameters
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"final position",
+			args{strings.NewReader(foolsMate), &PGNParameters{Ply: -1, Final: true}},
+			"2... Qh4#\n" +
+				"rnb*kbnr\n" +
+				"pppp*ppp\n" +
+				" * * * *\n" +
+				"* * p * \n" +
+				" * * *Pq\n" +
+				"* * *P* \n" +
+				"PPPPP* P\n" +
+				"RNBQKBNR\n",
+			assert.NoError,
+		},
+		{
+			"start position",
+			args{strings.NewReader(foolsMate), &PGNParameters{Ply: 0, Unicode: true}},
+			"start\n" +
+				"♜♞♝♛♚♝♞♜\n" +
+				"♟♟♟♟♟♟♟♟\n" +
+				" * * * *\n" +
+				"* * * * \n" +
+				" * * * *\n" +
+				"* * * * \n" +
+				"♙♙♙♙♙♙♙♙\n" +
+				"♖♘♗♕♔♗♘♖\n",
+			assert.NoError,
+		},
+		{
+			"every move",
+			args{strings.NewReader("[FEN \"7k/8/8/8/8/8/8/K7 w - - 0 1\"]\n1. Kb1 Kg8"), &PGNParameters{Ply: -1}},
+			"1. Kb1\n" +
+				" * * * k\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n * * * *\n*K* * * \n" +
+				"\n" +
+				"1... Kg8\n" +
+				" * * *k*\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n * * * *\n*K* * * \n",
+			assert.NoError,
+		},
+		{
+			"ply out of range",
+			args{strings.NewReader(foolsMate), &PGNParameters{Ply: 5}},
+			"", assert.Error,
+		},
+		{
+			"illegal move prints replayed positions",
+			args{strings.NewReader("[FEN \"7k/8/8/8/8/8/8/K7 w - - 0 1\"]\n1. Kb1 Kg9"), &PGNParameters{Ply: -1}},
+			"1. Kb1\n" +
+				" * * * k\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n * * * *\n*K* * * \n",
+			assert.Error,
+		},
+		{
+			"invalid fen",
+			args{strings.NewReader("[FEN \"8/8 w - -\"]\n1. e4"), &PGNParameters{Ply: -1}},
+			"", assert.Error,
+		},
+		{
+			"invalid pgn",
+			args{strings.NewReader("1. e4 {"), &PGNParameters{Ply: -1}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, PGN(tt.args.r, w, tt.args.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func Test_runPGN(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "game.pgn")
+	assert.NoError(t, os.WriteFile(filename, []byte(foolsMate), 0o644))
+
+	w := &bytes.Buffer{}
+	assert.NoError(t, runPGN(nil, w, []string{"-ply", "1", filename}))
+	assert.True(t, strings.HasPrefix(w.String(), "1. f3\n"))
+
+	assert.Error(t, runPGN(nil, w, []string{"-ply", "x"}))
+	assert.Error(t, runPGN(nil, w, []string{filepath.Join(t.TempDir(), "missing.pgn")}))
+}
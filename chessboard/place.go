@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/igkostyuk/dp210/chessboard/placement"
+)
+
+// PlaceParameters represent place command parameters.
+type PlaceParameters struct {
+	Piece  chess.PieceType
+	Pieces int
+	Height int
+	Width  int
+	// Samples is a number of placements to print instead of counting them.
+	Samples int
+	Unicode bool
+}
+
+func parsePlaceParameters(args []string) (*PlaceParameters, error) {
+	p := &PlaceParameters{}
+	fs := flag.NewFlagSet("place", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.IntVar(&p.Samples, "samples", 0, "number of placements to print")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw pieces with unicode glyphs")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if p.Samples < 0 {
+		return nil, fmt.Errorf("parse param samples: %w", ErrParameters)
+	}
+	if fs.NArg() != 4 {
+		return nil, ErrParameters
+	}
+	piece, err := placement.ParsePieceType(fs.Arg(0))
+	if err != nil {
+		return nil, fmt.Errorf("parse param piece: %w", err)
+	}
+	pieces, err := strconv.Atoi(fs.Arg(1))
+	if err != nil || pieces < 0 {
+		return nil, fmt.Errorf("parse param pieces: %w", placement.ErrPieces)
+	}
+	height, width, err := parseSize(fs.Args()[2:])
+	if err != nil {
+		return nil, err
+	}
+	p.Piece, p.Pieces, p.Height, p.Width = piece, pieces, height, width
+
+	return p, nil
+}
+
+func runPlace(_ io.Reader, w io.Writer, args []string) error {
+	p, err := parsePlaceParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing place parameters:%w", err)
+	}
+	return Place(w, p)
+}
+
+// Place write number of non-attacking pieces placements or sample boards with place parameters.
+func Place(w io.Writer, p *PlaceParameters) error {
+	if p.Samples == 0 {
+		count, err := placement.Count(p.Height, p.Width, p.Pieces, p.Piece)
+		if err != nil {
+			return fmt.Errorf("place counting arrangements:%w", err)
+		}
+		fmt.Fprintf(w, "arrangements: %d\n", count)
+		return nil
+	}
+
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	count := 0
+	var writeErr error
+	err := placement.Layouts(p.Height, p.Width, p.Pieces, p.Piece, func(l placement.Layout) bool {
+		if count > 0 {
+			fmt.Fprintln(w)
+		}
+		count++
+		b, err := l.Board(p.Height, p.Width, p.Piece, pieces)
+		if err == nil {
+			err = b.Write(w)
+		}
+		if err != nil {
+			writeErr = fmt.Errorf("place writing arrangement:%w", err)
+			return false
+		}
+		return count < p.Samples
+	})
+	switch {
+	case err != nil:
+		return fmt.Errorf("place searching arrangements:%w", err)
+	case writeErr != nil:
+		return writeErr
+	case count == 0:
+		fmt.Fprintln(w, "no arrangements")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parsePlaceParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *PlaceParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"piece name", args{[]string{"king", "16", "8", "8"}},
+			&PlaceParameters{Piece: chess.King, Pieces: 16, Height: 8, Width: 8}, assert.NoError,
+		},
+		{
+			"samples unicode letter", args{[]string{"-samples", "3", "-unicode", "N", "4", "3", "5"}},
+			&PlaceParameters{Piece: chess.Knight, Pieces: 4, Height: 3, Width: 5, Samples: 3, Unicode: true}, assert.NoError,
+		},
+		{"pawns", args{[]string{"pawn", "2", "4", "4"}}, nil, assert.Error},
+		{"negative pieces", args{[]string{"rook", "-1", "4", "4"}}, nil, assert.Error},
+		{"invalid pieces", args{[]string{"rook", "two", "4", "4"}}, nil, assert.Error},
+		{"negative samples", args{[]string{"-samples", "-1", "rook", "2", "4", "4"}}, nil, assert.Error},
+		{"missing width", args{[]string{"rook", "2", "4"}}, nil, assert.Error},
+		{"invalid height", args{[]string{"rook", "2", "0", "4"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown", "rook", "2", "4", "4"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePlaceParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlace(t *testing.T) {
+	type args struct {
+		p *PlaceParameters
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"count kings",
+			args{&PlaceParameters{Piece: chess.King, Pieces: 16, Height: 8, Width: 8}},
+			"arrangements: 281571\n", assert.NoError,
+		},
+		{
+			"count bishops",
+			args{&PlaceParameters{Piece: chess.Bishop, Pieces: 14, Height: 8, Width: 8}},
+			"arrangements: 256\n", assert.NoError,
+		},
+		{
+			"samples",
+			args{&PlaceParameters{Piece: chess.Knight, Pieces: 5, Height: 3, Width: 3, Samples: 5, Unicode: true}},
+			"♘ ♘\n ♘ \n♘ ♘\n\n" +
+				"*♘*\n♘♘♘\n*♘*\n", assert.NoError,
+		},
+		{
+			"first sample",
+			args{&PlaceParameters{Piece: chess.Rook, Pieces: 2, Height: 2, Width: 2, Samples: 1}},
+			"R*\n*R\n", assert.NoError,
+		},
+		{
+			"no arrangements",
+			args{&PlaceParameters{Piece: chess.Queen, Pieces: 3, Height: 3, Width: 3, Samples: 1}},
+			"no arrangements\n", assert.NoError,
+		},
+		{
+			"too large board",
+			args{&PlaceParameters{Piece: chess.Knight, Pieces: 1, Height: 30, Width: 30}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Place(w, tt.args.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
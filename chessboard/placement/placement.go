@@ -0,0 +1,422 @@
+// Package placement counts and lists placements of pieces of one type
+// on a rectangular board so that no piece attacks another.
+package placement
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strings"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/igkostyuk/dp210/chessboard/queens"
+)
+
+// MaxProfile is the largest number of squares kept in dynamic programming state.
+const MaxProfile = 24
+
+var (
+	// ErrPieceType indicates that a value is not a piece type name placements are counted for.
+	ErrPieceType = errors.New("piece should be one of king, knight, bishop, rook, queen")
+	// ErrPieces indicates that a number of pieces is negative.
+	ErrPieces = errors.New("number of pieces should be non-negative")
+	// ErrOverflow indicates that a number of placements does not fit into 64 bits.
+	ErrOverflow = errors.New("number of placements overflows 64 bits")
+	// ErrTooLarge indicates that a board is too large to count placements on.
+	ErrTooLarge = errors.New("board is too large to count placements")
+)
+
+var pieceTypes = map[string]chess.PieceType{
+	"king": chess.King, "knight": chess.Knight, "bishop": chess.Bishop, "rook": chess.Rook, "queen": chess.Queen,
+}
+
+// ParsePieceType return piece type named like knight or written as FEN letter like n.
+func ParsePieceType(name string) (chess.PieceType, error) {
+	name = strings.ToLower(name)
+	if t, ok := pieceTypes[name]; ok {
+		return t, nil
+	}
+	if p, ok := chess.PieceFromLetter([]rune(name + " ")[0]); ok && len([]rune(name)) == 1 && p.Type != chess.Pawn {
+		return p.Type, nil
+	}
+	return chess.NoPieceType, fmt.Errorf("parse piece %q:%w", name, ErrPieceType)
+}
+
+// Square represent zero based row and column of board square.
+type Square struct {
+	Row int
+	Col int
+}
+
+// Layout represent squares of placed pieces in row by row order.
+type Layout []Square
+
+func validate(height, width, k int, t chess.PieceType) error {
+	if height <= 0 || width <= 0 {
+		return fmt.Errorf("placement board %dx%d:%w", height, width, board.ErrSize)
+	}
+	if k < 0 {
+		return fmt.Errorf("placement %d pieces:%w", k, ErrPieces)
+	}
+	if _, ok := attacks[t]; !ok {
+		return fmt.Errorf("placement piece type %d:%w", t, ErrPieceType)
+	}
+	return nil
+}
+
+// Count return number of placements of k non-attacking pieces of type t on height x width board.
+// Kings and knights are counted with dynamic programming over squares keeping profile of last rows,
+// bishops with dynamic programming over diagonals of one color keeping used anti-diagonals.
+func Count(height, width, k int, t chess.PieceType) (uint64, error) {
+	if err := validate(height, width, k, t); err != nil {
+		return 0, err
+	}
+	if k > height*width {
+		return 0, nil
+	}
+	switch t {
+	case chess.Rook:
+		return countRooks(height, width, k)
+	case chess.Bishop:
+		return countBishops(height, width, k)
+	case chess.Queen:
+		if width > queens.MaxWidth {
+			height, width = width, height
+		}
+		if width > queens.MaxWidth {
+			return 0, fmt.Errorf("queens on %dx%d board:%w", height, width, ErrTooLarge)
+		}
+		n, err := queens.Count(height, width, k, nil)
+		return uint64(n), err
+	}
+	if width > height {
+		height, width = width, height
+	}
+	return countProfile(height, width, k, backward[t])
+}
+
+// attacks maps piece types to functions reporting whether pieces on squares a and b attack each other.
+// Pieces of one type attack each other along lines whatever stands between them,
+// because a piece standing between them is attacked too.
+var attacks = map[chess.PieceType]func(a, b Square) bool{
+	chess.King: func(a, b Square) bool {
+		dr, dc := abs(a.Row-b.Row), abs(a.Col-b.Col)
+		return dr <= 1 && dc <= 1
+	},
+	chess.Knight: func(a, b Square) bool {
+		dr, dc := abs(a.Row-b.Row), abs(a.Col-b.Col)
+		return dr == 1 && dc == 2 || dr == 2 && dc == 1
+	},
+	chess.Bishop: func(a, b Square) bool {
+		return abs(a.Row-b.Row) == abs(a.Col-b.Col)
+	},
+	chess.Rook: func(a, b Square) bool {
+		return a.Row == b.Row || a.Col == b.Col
+	},
+	chess.Queen: func(a, b Square) bool {
+		return a.Row == b.Row || a.Col == b.Col || abs(a.Row-b.Row) == abs(a.Col-b.Col)
+	},
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// maxPieces maps piece types to upper bounds of numbers of non-attacking pieces on height x width board.
+var maxPieces = map[chess.PieceType]func(height, width int) int{
+	chess.King: func(height, width int) int {
+		return (height + 1) / 2 * ((width + 1) / 2)
+	},
+	chess.Knight: func(height, width int) int {
+		if height > width {
+			height, width = width, height
+		}
+		switch height {
+		case 0, 1:
+			return height * width
+		case 2:
+			return 4*(width/4) + 2*minInt(width%4, 2)
+		}
+		return (height*width + 1) / 2
+	},
+	// bishops stand on distinct diagonals.
+	chess.Bishop: func(height, width int) int {
+		if height == 0 || width == 0 {
+			return 0
+		}
+		return height + width - 1
+	},
+	chess.Rook:  minInt,
+	chess.Queen: minInt,
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Layouts calls fn with every placement of k non-attacking pieces of type t on height x width board
+// in row by row order until fn returns false. Layout passed to fn can be retained.
+// Search skips squares after which the rest of the board can not hold pieces left to place,
+// so it stops at once when board can not hold k pieces.
+func Layouts(height, width, k int, t chess.PieceType, fn func(Layout) bool) error {
+	if err := validate(height, width, k, t); err != nil {
+		return err
+	}
+	s := &searcher{height: height, width: width, attacks: attacks[t], max: maxPieces[t], visit: fn}
+	s.search(0, k)
+	return nil
+}
+
+// searcher lists layouts with backtracking.
+type searcher struct {
+	height  int
+	width   int
+	attacks func(a, b Square) bool
+	max     func(height, width int) int
+	visit   func(Layout) bool
+	layout  Layout
+}
+
+// search places left pieces on squares starting from square number from.
+func (s *searcher) search(from, left int) bool {
+	if left == 0 {
+		return s.visit(append(Layout(nil), s.layout...))
+	}
+	for square := from; square <= s.height*s.width-left; square++ {
+		sq := Square{Row: square / s.width, Col: square % s.width}
+		if s.room(sq) < left {
+			return true
+		}
+		if s.attacked(sq) {
+			continue
+		}
+		s.layout = append(s.layout, sq)
+		ok := s.search(square+1, left-1)
+		s.layout = s.layout[:len(s.layout)-1]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// room return upper bound of pieces that can be placed on squares from sq on:
+// rows from sq row hold up to max pieces with pieces already placed in sq row,
+// and up to max pieces of rows below with pieces of sq row part from sq.
+func (s *searcher) room(sq Square) int {
+	inRow := 0
+	for i := len(s.layout) - 1; i >= 0 && s.layout[i].Row == sq.Row; i-- {
+		inRow++
+	}
+	below := s.height - sq.Row - 1
+	return minInt(s.max(below+1, s.width)-inRow, s.max(below, s.width)+s.max(1, s.width-sq.Col))
+}
+
+func (s *searcher) attacked(sq Square) bool {
+	for _, p := range s.layout {
+		if s.attacks(p, sq) {
+			return true
+		}
+	}
+	return false
+}
+
+// Board return board with layout pieces of type t drawn with pieces set over checker.
+func (l Layout) Board(height, width int, t chess.PieceType, pieces board.PieceSet) (*board.Board, error) {
+	if height <= 0 || width <= 0 {
+		return nil, board.ErrSize
+	}
+	letter := chess.Piece{Type: t, Color: chess.White}.Letter()
+	placement := make([][]rune, height)
+	for i := range placement {
+		placement[i] = make([]rune, width)
+	}
+	for _, sq := range l {
+		if sq.Row < 0 || sq.Row >= height || sq.Col < 0 || sq.Col >= width {
+			return nil, fmt.Errorf("layout square %d,%d:%w", sq.Row, sq.Col, board.ErrSize)
+		}
+		placement[sq.Row][sq.Col] = letter
+	}
+	return board.NewPieceBoard(placement, board.BlackSymbol, board.WhiteSymbol, pieces)
+}
+
+// countRooks return C(height, k) * C(width, k) * k! placements of rooks in distinct rows and columns.
+func countRooks(height, width, k int) (uint64, error) {
+	if k > height || k > width {
+		return 0, nil
+	}
+	n := new(big.Int).Binomial(int64(height), int64(k))
+	n.Mul(n, new(big.Int).Binomial(int64(width), int64(k)))
+	n.Mul(n, new(big.Int).MulRange(1, int64(k)))
+	if !n.IsUint64() {
+		return 0, fmt.Errorf("%d rooks on %dx%d board:%w", k, height, width, ErrOverflow)
+	}
+	return n.Uint64(), nil
+}
+
+// countBishops counts bishops on dark and light squares independently, bishops of one color are
+// rooks placed on diagonals and anti-diagonals, and combines their counts.
+func countBishops(height, width, k int) (uint64, error) {
+	if (height+width)/2+1 > MaxProfile {
+		return 0, fmt.Errorf("bishops on %dx%d board:%w", height, width, ErrTooLarge)
+	}
+	var colors [2][]uint64
+	for parity := range colors {
+		var err error
+		if colors[parity], err = countColorBishops(height, width, k, parity); err != nil {
+			return 0, err
+		}
+	}
+	var total uint64
+	for i := 0; i <= k; i++ {
+		n, err := mul(colors[0][i], colors[1][k-i])
+		if err == nil {
+			total, err = add(total, n)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%d bishops on %dx%d board:%w", k, height, width, err)
+		}
+	}
+	return total, nil
+}
+
+// countColorBishops return numbers of placements of up to k bishops on squares with row+col parity.
+func countColorBishops(height, width, k, parity int) ([]uint64, error) {
+	counts := map[uint32]uint64{0: 1}
+	for d := -(width - 1); d < height; d++ {
+		next := make(map[uint32]uint64, len(counts))
+		for mask, n := range counts {
+			next[mask] += n
+		}
+		for row := 0; row < height; row++ {
+			col := row - d
+			if col < 0 || col >= width || (row+col)%2 != parity {
+				continue
+			}
+			bit := uint32(1) << uint((row+col)/2)
+			for mask, n := range counts {
+				if mask&bit != 0 || bits.OnesCount32(mask) >= k {
+					continue
+				}
+				sum, err := add(next[mask|bit], n)
+				if err != nil {
+					return nil, err
+				}
+				next[mask|bit] = sum
+			}
+		}
+		counts = next
+	}
+	byPieces := make([]uint64, k+1)
+	for mask, n := range counts {
+		var err error
+		if byPieces[bits.OnesCount32(mask)], err = add(byPieces[bits.OnesCount32(mask)], n); err != nil {
+			return nil, err
+		}
+	}
+	return byPieces, nil
+}
+
+// backward maps piece types to attacked squares offsets preceding square in row by row order.
+var backward = map[chess.PieceType][]Square{
+	chess.King:   {{0, -1}, {-1, -1}, {-1, 0}, {-1, 1}},
+	chess.Knight: {{-1, -2}, {-1, 2}, {-2, -1}, {-2, 1}},
+}
+
+// countProfile counts placements of pieces attacking squares at backward offsets row by row,
+// square by square. State keeps which of the last squares, as far back as farthest offset, have pieces.
+func countProfile(height, width, k int, offsets []Square) (uint64, error) {
+	length := 0
+	for _, o := range offsets {
+		if l := -o.Row*width - o.Col; l > length {
+			length = l
+		}
+	}
+	if length > MaxProfile {
+		return 0, fmt.Errorf("pieces on %dx%d board:%w", height, width, ErrTooLarge)
+	}
+	full := uint32(1)<<uint(length) - 1
+	conflicts := make([]uint32, width)
+	for col := range conflicts {
+		for _, o := range offsets {
+			if c := col + o.Col; c >= 0 && c < width {
+				conflicts[col] |= 1 << uint(-o.Row*width-o.Col-1)
+			}
+		}
+	}
+
+	counts := map[uint32][]uint64{0: countsOf(k, 0, 1)}
+	for square := 0; square < height*width; square++ {
+		// squares before the first one are never occupied, so offsets above the board
+		// look at zero bits of state.
+		conflict := conflicts[square%width]
+		next := make(map[uint32][]uint64, 2*len(counts))
+		for state, n := range counts {
+			if err := addCounts(next, (state<<1)&full, n, 0); err != nil {
+				return 0, fmt.Errorf("%d pieces on %dx%d board:%w", k, height, width, err)
+			}
+			if state&conflict != 0 {
+				continue
+			}
+			if err := addCounts(next, (state<<1|1)&full, n, 1); err != nil {
+				return 0, fmt.Errorf("%d pieces on %dx%d board:%w", k, height, width, err)
+			}
+		}
+		counts = next
+	}
+	var total uint64
+	for _, n := range counts {
+		var err error
+		if total, err = add(total, n[k]); err != nil {
+			return 0, fmt.Errorf("%d pieces on %dx%d board:%w", k, height, width, err)
+		}
+	}
+	return total, nil
+}
+
+// countsOf return counts by number of pieces up to k with n placements of i pieces.
+func countsOf(k, i int, n uint64) []uint64 {
+	counts := make([]uint64, k+1)
+	counts[i] = n
+	return counts
+}
+
+// addCounts adds counts of state shifted by placed pieces to next state counts.
+func addCounts(next map[uint32][]uint64, state uint32, counts []uint64, placed int) error {
+	sum, ok := next[state]
+	if !ok {
+		sum = make([]uint64, len(counts))
+		next[state] = sum
+	}
+	for i := 0; i+placed < len(counts); i++ {
+		var err error
+		if sum[i+placed], err = add(sum[i+placed], counts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func add(a, b uint64) (uint64, error) {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+func mul(a, b uint64) (uint64, error) {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return 0, ErrOverflow
+	}
+	return lo, nil
+}
@@ -0,0 +1,176 @@
+package placement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePieceType(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      chess.PieceType
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"king", chess.King, assert.NoError},
+		{"Knight", chess.Knight, assert.NoError},
+		{"b", chess.Bishop, assert.NoError},
+		{"R", chess.Rook, assert.NoError},
+		{"queen", chess.Queen, assert.NoError},
+		{"pawn", chess.NoPieceType, assert.Error},
+		{"p", chess.NoPieceType, assert.Error},
+		{"", chess.NoPieceType, assert.Error},
+		{"kn", chess.NoPieceType, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePieceType(tt.name)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	type args struct {
+		height int
+		width  int
+		k      int
+		t      chess.PieceType
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      uint64
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"kings chess board", args{8, 8, 16, chess.King}, 281571, assert.NoError},
+		{"kings three by three", args{3, 3, 4, chess.King}, 1, assert.NoError},
+		{"knights chess board", args{8, 8, 32, chess.Knight}, 2, assert.NoError},
+		{"knights three by three", args{3, 3, 5, chess.Knight}, 2, assert.NoError},
+		{"bishops chess board", args{8, 8, 14, chess.Bishop}, 256, assert.NoError},
+		{"rooks chess board", args{8, 8, 8, chess.Rook}, 40320, assert.NoError},
+		{"rooks rectangle", args{2, 3, 2, chess.Rook}, 6, assert.NoError},
+		{"queens chess board", args{8, 8, 8, chess.Queen}, 92, assert.NoError},
+		{"no pieces", args{5, 7, 0, chess.Knight}, 1, assert.NoError},
+		{"more pieces than squares", args{2, 2, 5, chess.King}, 0, assert.NoError},
+		{"too many rooks", args{2, 5, 3, chess.Rook}, 0, assert.NoError},
+		{"rooks overflow", args{30, 30, 30, chess.Rook}, 0, assert.Error},
+		{"knights too large", args{20, 20, 1, chess.Knight}, 0, assert.Error},
+		{"pawns", args{8, 8, 8, chess.Pawn}, 0, assert.Error},
+		{"negative pieces", args{8, 8, -1, chess.King}, 0, assert.Error},
+		{"invalid size", args{0, 8, 1, chess.King}, 0, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Count(tt.args.height, tt.args.width, tt.args.k, tt.args.t)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCount_layouts(t *testing.T) {
+	for _, pt := range []chess.PieceType{chess.King, chess.Knight, chess.Bishop, chess.Rook, chess.Queen} {
+		for height := 1; height <= 4; height++ {
+			for width := 1; width <= 5; width++ {
+				for k := 0; k <= 6; k++ {
+					want := uint64(0)
+					assert.NoError(t, Layouts(height, width, k, pt, func(Layout) bool {
+						want++
+						return true
+					}))
+					got, err := Count(height, width, k, pt)
+					assert.NoError(t, err)
+					assert.Equal(t, want, got, "%d pieces %d on %dx%d", k, pt, height, width)
+				}
+			}
+		}
+	}
+}
+
+func TestCount_transposed(t *testing.T) {
+	for _, pt := range []chess.PieceType{chess.King, chess.Knight} {
+		wide, err := Count(3, 40, 10, pt)
+		assert.NoError(t, err)
+		tall, err := Count(40, 3, 10, pt)
+		assert.NoError(t, err)
+		assert.Equal(t, wide, tall)
+		assert.NotZero(t, wide)
+	}
+}
+
+func TestLayouts(t *testing.T) {
+	var got []Layout
+	assert.NoError(t, Layouts(2, 3, 2, chess.King, func(l Layout) bool {
+		got = append(got, l)
+		return len(got) < 3
+	}))
+	assert.Equal(t, []Layout{
+		{{0, 0}, {0, 2}},
+		{{0, 0}, {1, 2}},
+		{{0, 2}, {1, 0}},
+	}, got)
+	assert.Error(t, Layouts(2, 3, 2, chess.Pawn, func(Layout) bool { return true }))
+}
+
+func TestLayouts_infeasible(t *testing.T) {
+	tests := []struct {
+		name   string
+		height int
+		width  int
+		k      int
+		pt     chess.PieceType
+	}{
+		{"counted kings", 8, 8, 17, chess.King},
+		{"counted knights", 8, 8, 33, chess.Knight},
+		{"kings on large board", 30, 30, 226, chess.King},
+		{"bishops on large board", 30, 30, 60, chess.Bishop},
+		{"queens on large board", 30, 30, 31, chess.Queen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			assert.NoError(t, Layouts(tt.height, tt.width, tt.k, tt.pt, func(Layout) bool {
+				t.Fatal("layout of infeasible placement")
+				return false
+			}))
+			assert.Less(t, int64(time.Since(start)), int64(2*time.Second))
+		})
+	}
+}
+
+func TestLayouts_firstQueens(t *testing.T) {
+	start := time.Now()
+	found := false
+	assert.NoError(t, Layouts(14, 14, 14, chess.Queen, func(Layout) bool {
+		found = true
+		return false
+	}))
+	assert.True(t, found)
+	assert.Less(t, int64(time.Since(start)), int64(2*time.Second))
+}
+
+func TestLayout_Board(t *testing.T) {
+	br, err := Layout{{0, 0}, {1, 2}}.Board(2, 3, chess.Knight, board.UnicodePieces)
+	assert.NoError(t, err)
+	assert.Equal(t, "♘* \n* ♘\n", br.String())
+
+	_, err = Layout{{2, 0}}.Board(2, 3, chess.Knight, board.ASCIIPieces)
+	assert.Error(t, err)
+}
+
+func BenchmarkCount_knights(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Count(8, 8, 20, chess.Knight)
+	}
+}
+
+func BenchmarkCount_kings(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Count(10, 10, 20, chess.King)
+	}
+}
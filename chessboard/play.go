@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/igkostyuk/dp210/chessboard/game"
+)
+
+var (
+	// ErrClock indicates that a clock time is negative.
+	ErrClock = errors.New("clock time and increment should be non-negative")
+
+	moveNumberToken = regexp.MustCompile(`^\d+\.+$`)
+)
+
+// PlayParameters represent play command parameters.
+type PlayParameters struct {
+	File    string
+	FEN     string
+	Unicode bool
+	// Time is an initial time of each player, zero for game without clock.
+	Time      time.Duration
+	Increment time.Duration
+}
+
+func parsePlayParameters(args []string) (*PlayParameters, error) {
+	p := &PlayParameters{}
+	fs := flag.NewFlagSet("play", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.FEN, "fen", chess.StartFEN, "start position")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw pieces with unicode glyphs")
+	fs.DurationVar(&p.Time, "time", 0, "initial time of each player")
+	fs.DurationVar(&p.Increment, "increment", 0, "time added after each move")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if p.Time < 0 || p.Increment < 0 {
+		return nil, fmt.Errorf("parse param time: %w", ErrClock)
+	}
+	if p.Increment > 0 && p.Time == 0 {
+		return nil, fmt.Errorf("increment without time:%w", ErrParameters)
+	}
+	if fs.NArg() > 1 {
+		return nil, ErrParameters
+	}
+	p.File = fs.Arg(0)
+
+	return p, nil
+}
+
+func runPlay(r io.Reader, w io.Writer, args []string) error {
+	p, err := parsePlayParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing play parameters:%w", err)
+	}
+	if p.File != "" {
+		f, err := os.Open(p.File)
+		if err != nil {
+			return fmt.Errorf("opening moves file:%w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	return Play(r, w, p)
+}
+
+// Play runs two-player game reading moves from reader and writing board
+// after every move with play parameters, until game ends or reader does.
+func Play(r io.Reader, w io.Writer, p *PlayParameters) error {
+	return play(r, w, p, time.Now)
+}
+
+// play runs game measuring players thinking time with now.
+func play(r io.Reader, w io.Writer, p *PlayParameters, now func() time.Time) error {
+	pos, err := chess.ParseFEN(p.FEN)
+	if err != nil {
+		return fmt.Errorf("play parsing fen:%w", err)
+	}
+	var clock *game.Clock
+	if p.Time > 0 {
+		clock = game.NewClock(p.Time, p.Increment)
+	}
+	g := game.New(pos, clock)
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	if err := writeTurn(w, g, pieces); err != nil {
+		return err
+	}
+
+	sc := bufio.NewScanner(r)
+	start := now()
+	for g.Outcome == nil && sc.Scan() {
+		for _, token := range strings.Fields(sc.Text()) {
+			if moveNumberToken.MatchString(token) {
+				continue
+			}
+			_, err := g.Play(token, now().Sub(start))
+			start = now()
+			switch {
+			case errors.Is(err, game.ErrTimeout):
+				// board is written with timeout outcome.
+			case errors.Is(err, chess.ErrIllegalMove) || errors.Is(err, chess.ErrAmbiguousMove):
+				fmt.Fprintln(w, err)
+				continue
+			case err != nil:
+				return fmt.Errorf("playing move:%w", err)
+			}
+			if err := writeTurn(w, g, pieces); err != nil {
+				return err
+			}
+			if g.Outcome != nil {
+				break
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("reading moves:%w", err)
+	}
+	return nil
+}
+
+// writeTurn write game board, clock and prompt of side to move or game outcome.
+func writeTurn(w io.Writer, g *game.Game, pieces board.PieceSet) error {
+	b, err := g.Position.Board(pieces)
+	if err != nil {
+		return fmt.Errorf("play creating board:%w", err)
+	}
+	if err := b.Write(w); err != nil {
+		return fmt.Errorf("play writing board:%w", err)
+	}
+	if g.Clock != nil {
+		fmt.Fprintln(w, g.Clock)
+	}
+	if g.Outcome != nil {
+		fmt.Fprintf(w, "result: %s\n", g.Outcome)
+		return nil
+	}
+	pos := g.Position
+	fmt.Fprintf(w, "%d%s %s to move\n", pos.FullMoveNumber, chess.MoveNumberSuffix(pos.Turn), pos.Turn)
+	return nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/chess"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parsePlayParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *PlayParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"defaults", args{nil}, &PlayParameters{FEN: chess.StartFEN}, assert.NoError},
+		{
+			"clock and file", args{[]string{"-time", "5m", "-increment", "3s", "-unicode", "moves.txt"}},
+			&PlayParameters{File: "moves.txt", FEN: chess.StartFEN, Unicode: true, Time: 5 * time.Minute, Increment: 3 * time.Second},
+			assert.NoError,
+		},
+		{"negative time", args{[]string{"-time", "-1m"}}, nil, assert.Error},
+		{"increment without time", args{[]string{"-increment", "2s"}}, nil, assert.Error},
+		{"invalid duration", args{[]string{"-time", "5"}}, nil, assert.Error},
+		{"two files", args{[]string{"a.txt", "b.txt"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePlayParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         *PlayParameters
+		moves     string
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"checkmate",
+			&PlayParameters{FEN: "7k/8/6K1/8/8/8/8/R7 w - - 0 1"},
+			"Ra8#\nKh7\n",
+			" * * * k\n* * * * \n * * *K*\n* * * * \n * * * *\n* * * * \n * * * *\nR * * * \n" +
+				"1. white to move\n" +
+				"R* * * k\n* * * * \n * * *K*\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n" +
+				"result: 1-0 checkmate\n",
+			assert.NoError,
+		},
+		{
+			"illegal and coordinate moves",
+			&PlayParameters{FEN: "4k3/8/8/8/8/8/8/4K3 w - - 0 1"},
+			"1. Ke3 e1d2",
+			" * *k* *\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n * * * *\n* * K * \n" +
+				"1. white to move\n" +
+				"move Ke3:illegal move\n" +
+				" * *k* *\n* * * * \n * * * *\n* * * * \n * * * *\n* * * * \n * K * *\n* * * * \n" +
+				"1... black to move\n",
+			assert.NoError,
+		},
+		{
+			"invalid fen",
+			&PlayParameters{FEN: "8/8 w"},
+			"", "", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Play(strings.NewReader(tt.moves), w, tt.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func Test_play_clock(t *testing.T) {
+	// every call of now moves fake time by ten seconds.
+	clock := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time {
+		clock = clock.Add(10 * time.Second)
+		return clock
+	}
+	p := &PlayParameters{FEN: "4k3/4p3/8/8/8/8/8/R3K3 w - - 0 1", Time: 15 * time.Second, Increment: 2 * time.Second}
+	w := &bytes.Buffer{}
+	assert.NoError(t, play(strings.NewReader("Ra2\nKd8\nRa3\n"), w, p, now))
+
+	lines := strings.Split(strings.TrimSpace(w.String()), "\n")
+	var clocks []string
+	for _, l := range lines {
+		if strings.HasPrefix(l, "white ") || strings.HasPrefix(l, "result") {
+			clocks = append(clocks, l)
+		}
+	}
+	assert.Equal(t, []string{
+		"white 0:15 black 0:15",
+		"white 0:07.0 black 0:15",
+		"white 0:07.0 black 0:07.0",
+		"white 0:00.0 black 0:07.0",
+		"result: 0-1 timeout",
+	}, clocks)
+}
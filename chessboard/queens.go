@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/queens"
+)
+
+// QueensParameters represent queens command parameters.
+type QueensParameters struct {
+	Height int
+	Width  int
+	// N is a number of queens, zero for the smaller board side.
+	N        int
+	All      bool
+	Count    bool
+	Progress bool
+	Unicode  bool
+}
+
+func parseQueensParameters(args []string) (*QueensParameters, error) {
+	p := &QueensParameters{}
+	fs := flag.NewFlagSet("queens", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.IntVar(&p.N, "n", 0, "number of queens")
+	fs.BoolVar(&p.All, "all", false, "print all solutions")
+	fs.BoolVar(&p.Count, "count", false, "print only number of solutions")
+	fs.BoolVar(&p.Progress, "progress", false, "print counting progress")
+	fs.BoolVar(&p.Unicode, "unicode", false, "draw queens with unicode glyphs")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if p.All && p.Count {
+		return nil, fmt.Errorf("all and count flags together:%w", ErrParameters)
+	}
+	if p.N < 0 {
+		return nil, fmt.Errorf("parse param n: %w", queens.ErrQueens)
+	}
+	height, width, err := parseSize(fs.Args())
+	if err != nil {
+		return nil, err
+	}
+	p.Height, p.Width = height, width
+
+	return p, nil
+}
+
+func runQueens(_ io.Reader, w io.Writer, args []string) error {
+	p, err := parseQueensParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing queens parameters:%w", err)
+	}
+	return Queens(w, p)
+}
+
+// Queens write boards with non-attacking queens placements with queens parameters.
+func Queens(w io.Writer, p *QueensParameters) error {
+	n := p.N
+	if n == 0 {
+		n = p.Height
+		if p.Width < n {
+			n = p.Width
+		}
+	}
+
+	if p.Count {
+		var progress func(queens.Progress)
+		if p.Progress {
+			progress = func(pr queens.Progress) {
+				fmt.Fprintf(w, "progress: %d/%d solutions: %d\n", pr.Done, pr.Total, pr.Solutions)
+			}
+		}
+		count, err := queens.Count(p.Height, p.Width, n, progress)
+		if err != nil {
+			return fmt.Errorf("queens counting solutions:%w", err)
+		}
+		fmt.Fprintf(w, "solutions: %d\n", count)
+		return nil
+	}
+
+	pieces := board.ASCIIPieces
+	if p.Unicode {
+		pieces = board.UnicodePieces
+	}
+	count := 0
+	var writeErr error
+	err := queens.Solve(p.Height, p.Width, n, func(s queens.Solution) bool {
+		if count > 0 {
+			fmt.Fprintln(w)
+		}
+		count++
+		b, err := s.Board(p.Width, pieces)
+		if err == nil {
+			err = b.Write(w)
+		}
+		if err != nil {
+			writeErr = fmt.Errorf("queens writing solution:%w", err)
+			return false
+		}
+		return p.All
+	})
+	switch {
+	case err != nil:
+		return fmt.Errorf("queens solving:%w", err)
+	case writeErr != nil:
+		return writeErr
+	case count == 0:
+		fmt.Fprintln(w, "no solutions")
+	case p.All:
+		fmt.Fprintf(w, "\nsolutions: %d\n", count)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Package queens solves problem of placing non-attacking queens on a rectangular board.
+package queens
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+// MaxWidth is the widest board queens are placed on, rows are kept as bitmasks.
+const MaxWidth = 64
+
+var (
+	// ErrQueens indicates that a number of queens is negative.
+	ErrQueens = errors.New("number of queens should be non-negative")
+)
+
+// Solution represent queen columns by rows, -1 for a row without queen.
+type Solution []int
+
+// Progress represent counting progress reported after every finished first row branch.
+type Progress struct {
+	Done      int
+	Total     int
+	Solutions int
+}
+
+// solver finds placements with bitmask backtracking.
+type solver struct {
+	height  int
+	width   int
+	full    uint64
+	columns Solution
+	visit   func(Solution) bool
+	count   int
+}
+
+func newSolver(height, width, n int, visit func(Solution) bool) (*solver, error) {
+	if height <= 0 || width <= 0 || width > MaxWidth {
+		return nil, fmt.Errorf("queens board %dx%d:%w", height, width, board.ErrSize)
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("queens %d:%w", n, ErrQueens)
+	}
+	full := ^uint64(0)
+	if width < MaxWidth {
+		full = 1<<uint(width) - 1
+	}
+	return &solver{
+		height:  height,
+		width:   width,
+		full:    full,
+		columns: make(Solution, height),
+		visit:   visit,
+	}, nil
+}
+
+// Solve calls fn with every placement of n non-attacking queens on height x width board
+// until fn returns false. Solution passed to fn can be retained.
+func Solve(height, width, n int, fn func(Solution) bool) error {
+	s, err := newSolver(height, width, n, fn)
+	if err != nil {
+		return err
+	}
+	s.solve(0, n, 0, 0, 0)
+	return nil
+}
+
+// Count return number of placements of n non-attacking queens on height x width board,
+// progress is called after every branch of the first row when it is not nil.
+func Count(height, width, n int, progress func(Progress)) (int, error) {
+	s, err := newSolver(height, width, n, nil)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 || !s.feasible(0, n, 0) {
+		s.solve(0, n, 0, 0, 0)
+		return s.count, nil
+	}
+	branches := make([]int, 0, width+1)
+	for col := 0; col < width; col++ {
+		branches = append(branches, col)
+	}
+	if height > n {
+		branches = append(branches, -1)
+	}
+	for i, col := range branches {
+		s.place(0, n, 0, 0, 0, col)
+		if progress != nil {
+			progress(Progress{Done: i + 1, Total: len(branches), Solutions: s.count})
+		}
+	}
+	return s.count, nil
+}
+
+// feasible reports whether left queens still fit into free rows and columns.
+func (s *solver) feasible(row, left int, cols uint64) bool {
+	return left <= s.height-row && left <= s.width-bits.OnesCount64(cols)
+}
+
+// solve places left queens on rows starting from row,
+// cols, ld and rd are columns attacked by queens vertically and diagonally.
+func (s *solver) solve(row, left int, cols, ld, rd uint64) bool {
+	if left == 0 {
+		s.count++
+		if s.visit == nil {
+			return true
+		}
+		for r := row; r < s.height; r++ {
+			s.columns[r] = -1
+		}
+		return s.visit(append(Solution(nil), s.columns...))
+	}
+	if !s.feasible(row, left, cols) {
+		return true
+	}
+	for free := s.full &^ (cols | ld | rd); free != 0; free &= free - 1 {
+		if !s.place(row, left, cols, ld, rd, bits.TrailingZeros64(free)) {
+			return false
+		}
+	}
+	if s.height-row > left {
+		return s.place(row, left, cols, ld, rd, -1)
+	}
+	return true
+}
+
+// place puts queen in column col of row, -1 leaves row empty, and solves next rows.
+func (s *solver) place(row, left int, cols, ld, rd uint64, col int) bool {
+	if col >= 0 {
+		bit := uint64(1) << uint(col)
+		cols, ld, rd, left = cols|bit, ld|bit, rd|bit, left-1
+	}
+	s.columns[row] = col
+	return s.solve(row+1, left, cols, (ld<<1)&s.full, rd>>1)
+}
+
+// Placement return solution as FEN piece placement of white queens on board with width.
+func (s Solution) Placement(width int) string {
+	ranks := make([]string, len(s))
+	for i, col := range s {
+		if col < 0 {
+			ranks[i] = strconv.Itoa(width)
+			continue
+		}
+		var b strings.Builder
+		if col > 0 {
+			b.WriteString(strconv.Itoa(col))
+		}
+		b.WriteRune('Q')
+		if rest := width - col - 1; rest > 0 {
+			b.WriteString(strconv.Itoa(rest))
+		}
+		ranks[i] = b.String()
+	}
+	return strings.Join(ranks, "/")
+}
+
+// Board return board with solution queens drawn with pieces set over checker.
+func (s Solution) Board(width int, pieces board.PieceSet) (*board.Board, error) {
+	return board.FromFEN(s.Placement(width), board.BlackSymbol, board.WhiteSymbol, pieces)
+}
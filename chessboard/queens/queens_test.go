@@ -0,0 +1,119 @@
+package queens
+
+import (
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	type args struct {
+		height int
+		width  int
+		n      int
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      int
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"one square", args{1, 1, 1}, 1, assert.NoError},
+		{"two squares", args{2, 2, 2}, 0, assert.NoError},
+		{"three squares", args{3, 3, 3}, 0, assert.NoError},
+		{"four squares", args{4, 4, 4}, 2, assert.NoError},
+		{"six squares", args{6, 6, 6}, 4, assert.NoError},
+		{"eight squares", args{8, 8, 8}, 92, assert.NoError},
+		{"ten squares", args{10, 10, 10}, 724, assert.NoError},
+		{"wide board", args{2, 3, 2}, 2, assert.NoError},
+		{"tall board", args{3, 2, 2}, 2, assert.NoError},
+		{"fewer queens than rows", args{3, 3, 2}, 8, assert.NoError},
+		{"more queens than columns", args{5, 3, 4}, 0, assert.NoError},
+		{"no queens", args{2, 2, 0}, 1, assert.NoError},
+		{"negative queens", args{2, 2, -1}, 0, assert.Error},
+		{"zero height", args{0, 2, 1}, 0, assert.Error},
+		{"too wide", args{1, MaxWidth + 1, 1}, 0, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Count(tt.args.height, tt.args.width, tt.args.n, nil)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCount_progress(t *testing.T) {
+	var got []Progress
+	count, err := Count(4, 4, 4, func(p Progress) { got = append(got, p) })
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []Progress{
+		{Done: 1, Total: 4, Solutions: 0},
+		{Done: 2, Total: 4, Solutions: 1},
+		{Done: 3, Total: 4, Solutions: 2},
+		{Done: 4, Total: 4, Solutions: 2},
+	}, got)
+}
+
+func TestSolve(t *testing.T) {
+	var got []Solution
+	assert.NoError(t, Solve(4, 4, 4, func(s Solution) bool {
+		got = append(got, s)
+		return true
+	}))
+	assert.Equal(t, []Solution{{1, 3, 0, 2}, {2, 0, 3, 1}}, got)
+}
+
+func TestSolve_stop(t *testing.T) {
+	var got []Solution
+	assert.NoError(t, Solve(8, 8, 8, func(s Solution) bool {
+		got = append(got, s)
+		return false
+	}))
+	assert.Equal(t, []Solution{{0, 4, 7, 5, 2, 6, 1, 3}}, got)
+}
+
+func TestSolve_emptyRows(t *testing.T) {
+	var got []Solution
+	assert.NoError(t, Solve(3, 2, 2, func(s Solution) bool {
+		got = append(got, s)
+		return true
+	}))
+	assert.Equal(t, []Solution{{0, -1, 1}, {1, -1, 0}}, got)
+}
+
+func TestSolve_invalid(t *testing.T) {
+	assert.Error(t, Solve(2, 0, 1, func(Solution) bool { return true }))
+}
+
+func TestSolution_Placement(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     Solution
+		width int
+		want  string
+	}{
+		{"four queens", Solution{1, 3, 0, 2}, 4, "1Q2/3Q/Q3/2Q1"},
+		{"empty row", Solution{0, -1, 1}, 2, "Q1/2/1Q"},
+		{"wide board", Solution{11}, 12, "11Q"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.s.Placement(tt.width))
+		})
+	}
+}
+
+func TestSolution_Board(t *testing.T) {
+	br, err := Solution{1, 3, 0, 2}.Board(4, board.UnicodePieces)
+	assert.NoError(t, err)
+	assert.Equal(t, " ♕ *\n* *♕\n♕* *\n* ♕ \n", br.String())
+}
+
+func BenchmarkCount(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Count(10, 10, 10, nil)
+	}
+}
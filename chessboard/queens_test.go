@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseQueensParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *QueensParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"size only", args{[]string{"8", "8"}},
+			&QueensParameters{Height: 8, Width: 8}, assert.NoError,
+		},
+		{
+			"count with progress", args{[]string{"-n", "5", "-count", "-progress", "6", "7"}},
+			&QueensParameters{Height: 6, Width: 7, N: 5, Count: true, Progress: true}, assert.NoError,
+		},
+		{
+			"all unicode", args{[]string{"-all", "-unicode", "4", "4"}},
+			&QueensParameters{Height: 4, Width: 4, All: true, Unicode: true}, assert.NoError,
+		},
+		{"all and count", args{[]string{"-all", "-count", "4", "4"}}, nil, assert.Error},
+		{"negative queens", args{[]string{"-n", "-1", "4", "4"}}, nil, assert.Error},
+		{"missing width", args{[]string{"4"}}, nil, assert.Error},
+		{"invalid height", args{[]string{"0", "4"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown", "4", "4"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseQueensParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQueens(t *testing.T) {
+	type args struct {
+		p *QueensParameters
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"first solution",
+			args{&QueensParameters{Height: 4, Width: 4}},
+			" Q *\n* *Q\nQ* *\n* Q \n", assert.NoError,
+		},
+		{
+			"all solutions",
+			args{&QueensParameters{Height: 4, Width: 4, All: true, Unicode: true}},
+			" ♕ *\n* *♕\n♕* *\n* ♕ \n\n" +
+				" *♕*\n♕ * \n * ♕\n*♕* \n\n" +
+				"solutions: 2\n", assert.NoError,
+		},
+		{
+			"fewer queens",
+			args{&QueensParameters{Height: 3, Width: 2, N: 2}},
+			"Q \n *\n*Q\n", assert.NoError,
+		},
+		{
+			"no solutions",
+			args{&QueensParameters{Height: 3, Width: 3}},
+			"no solutions\n", assert.NoError,
+		},
+		{
+			"count",
+			args{&QueensParameters{Height: 8, Width: 8, Count: true}},
+			"solutions: 92\n", assert.NoError,
+		},
+		{
+			"count with progress",
+			args{&QueensParameters{Height: 2, Width: 3, Count: true, Progress: true}},
+			"progress: 1/3 solutions: 1\n" +
+				"progress: 2/3 solutions: 1\n" +
+				"progress: 3/3 solutions: 2\n" +
+				"solutions: 2\n", assert.NoError,
+		},
+		{
+			"too wide board",
+			args{&QueensParameters{Height: 1, Width: 65, Count: true}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Queens(w, tt.args.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
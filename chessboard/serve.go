@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+// MaxServeSize is a default largest board height and width served.
+const MaxServeSize = 64
+
+var (
+	// ErrQuery indicates that a board query parameter has invalid value.
+	ErrQuery = errors.New("invalid board query parameter")
+
+	contentTypes = map[string]string{
+		"text": "text/plain; charset=utf-8",
+		"svg":  "image/svg+xml",
+		"json": "application/json",
+	}
+)
+
+// ServeParameters represent serve command parameters.
+type ServeParameters struct {
+	Addr    string
+	MaxSize int
+}
+
+func parseServeParameters(args []string) (*ServeParameters, error) {
+	p := &ServeParameters{}
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.Addr, "addr", "localhost:8080", "address to listen on")
+	fs.IntVar(&p.MaxSize, "max", MaxServeSize, "largest board height and width")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if fs.NArg() != 0 {
+		return nil, ErrParameters
+	}
+	if p.MaxSize <= 0 {
+		return nil, fmt.Errorf("parse param max: %w", board.ErrSize)
+	}
+
+	return p, nil
+}
+
+func runServe(_ io.Reader, w io.Writer, args []string) error {
+	p, err := parseServeParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing serve parameters:%w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/board", BoardHandler{MaxSize: p.MaxSize})
+	server := &http.Server{Addr: p.Addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	fmt.Fprintf(w, "serving boards on http://%s/board\n", p.Addr)
+	if err := server.ListenAndServe(); err != nil {
+		return fmt.Errorf("serving:%w", err)
+	}
+	return nil
+}
+
+// BoardHandler serves boards rendered by query parameters h, w, format, fen and unicode,
+// boards higher or wider than MaxSize are rejected.
+type BoardHandler struct {
+	MaxSize int
+}
+
+func (h BoardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	p, err := parseBoardQuery(r.URL.Query(), h.MaxSize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, err := newBoard(p)
+	if err == nil && (b.Height > h.MaxSize || b.Width > h.MaxSize) {
+		err = fmt.Errorf("board %dx%d larger than %d:%w", b.Height, b.Width, h.MaxSize, board.ErrSize)
+	}
+	if err == nil && p.Height > 0 && (b.Height != p.Height || b.Width != p.Width) {
+		err = fmt.Errorf("board %dx%d, want %dx%d:%w", b.Height, b.Width, p.Height, p.Width, board.ErrSize)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var buf bytes.Buffer
+	if err := Render(&buf, b, p); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypes[p.Format])
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	if r.Method == http.MethodGet {
+		buf.WriteTo(w)
+	}
+}
+
+// parseBoardQuery return parameters of board query, sizes are optional for FEN boards.
+func parseBoardQuery(q url.Values, maxSize int) (*Parameters, error) {
+	p := &Parameters{Format: q.Get("format"), FEN: q.Get("fen")}
+	if p.Format == "" {
+		p.Format = "text"
+	}
+	if _, ok := contentTypes[p.Format]; !ok {
+		return nil, fmt.Errorf("parse param format %q:%w", p.Format, ErrFormat)
+	}
+	if u := q.Get("unicode"); u != "" {
+		unicode, err := strconv.ParseBool(u)
+		if err != nil {
+			return nil, fmt.Errorf("parse param unicode %q:%w", u, ErrQuery)
+		}
+		p.Unicode = unicode
+	}
+	if p.FEN != "" && !fenWithin(p.FEN, maxSize) {
+		return nil, fmt.Errorf("parse param fen: board larger than %d:%w", maxSize, board.ErrSize)
+	}
+	if p.FEN != "" && q.Get("h") == "" && q.Get("w") == "" {
+		return p, nil
+	}
+	for _, s := range []struct {
+		name string
+		size *int
+	}{{"h", &p.Height}, {"w", &p.Width}} {
+		v, err := strconv.Atoi(q.Get(s.name))
+		if err != nil || v <= 0 || v > maxSize {
+			return nil, fmt.Errorf("parse param %s %q, want up to %d:%w", s.name, q.Get(s.name), maxSize, board.ErrSize)
+		}
+		*s.size = v
+	}
+	return p, nil
+}
+
+// fenWithin reports whether fen placement length and every its empty squares number
+// are small enough for board with sizes up to maxSize, so that parsing it is cheap.
+func fenWithin(fen string, maxSize int) bool {
+	fields := strings.Fields(fen)
+	if len(fields) == 0 {
+		return true
+	}
+	placement := fields[0]
+	if len(placement) > maxSize*(maxSize+1) {
+		return false
+	}
+	empty := 0
+	for _, c := range placement {
+		if c < '0' || c > '9' {
+			empty = 0
+			continue
+		}
+		if empty = empty*10 + int(c-'0'); empty > maxSize {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseServeParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *ServeParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"defaults", args{nil}, &ServeParameters{Addr: "localhost:8080", MaxSize: MaxServeSize}, assert.NoError},
+		{"address and limit", args{[]string{"-addr", ":9000", "-max", "16"}}, &ServeParameters{Addr: ":9000", MaxSize: 16}, assert.NoError},
+		{"zero limit", args{[]string{"-max", "0"}}, nil, assert.Error},
+		{"extra argument", args{[]string{"8"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseServeParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBoardHandler(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		query           string
+		wantCode        int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			"text", http.MethodGet, "h=2&w=3",
+			http.StatusOK, "text/plain; charset=utf-8", "* *\n * \n",
+		},
+		{
+			"json fen", http.MethodGet, "format=json&fen=" + url.QueryEscape("k1/2"),
+			http.StatusOK, "application/json",
+			"{\"height\":2,\"width\":2,\"symbols\":[\"*\",\" \"],\"colors\":[[1,0],[0,1]],\"fen\":\"k1/2\"}\n",
+		},
+		{
+			"unicode fen with sizes", http.MethodGet, "h=1&w=2&unicode=true&fen=1Q",
+			http.StatusOK, "text/plain; charset=utf-8", "*♕\n",
+		},
+		{
+			"head", http.MethodHead, "h=1&w=1",
+			http.StatusOK, "text/plain; charset=utf-8", "",
+		},
+		{
+			"missing width", http.MethodGet, "h=2",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"parse param w \"\", want up to 8:size should be a positive integer\n",
+		},
+		{
+			"too high", http.MethodGet, "h=9&w=2",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"parse param h \"9\", want up to 8:size should be a positive integer\n",
+		},
+		{
+			"too wide fen", http.MethodGet, "fen=9",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"parse param fen: board larger than 8:size should be a positive integer\n",
+		},
+		{
+			"too many fen ranks", http.MethodGet, "fen=" + strings.Repeat("1/", 8) + "1",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"board 9x1 larger than 8:size should be a positive integer\n",
+		},
+		{
+			"fen size mismatch", http.MethodGet, "h=2&w=2&fen=1",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"board 1x1, want 2x2:size should be a positive integer\n",
+		},
+		{
+			"invalid fen", http.MethodGet, "fen=x",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"rank 1 piece 'x':invalid FEN piece placement\n",
+		},
+		{
+			"unknown format", http.MethodGet, "h=1&w=1&format=png",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"parse param format \"png\":format should be one of text, svg, png, json, csv\n",
+		},
+		{
+			"invalid unicode", http.MethodGet, "fen=1&unicode=maybe",
+			http.StatusBadRequest, "text/plain; charset=utf-8",
+			"parse param unicode \"maybe\":invalid board query parameter\n",
+		},
+		{
+			"post", http.MethodPost, "h=1&w=1",
+			http.StatusMethodNotAllowed, "text/plain; charset=utf-8", "method not allowed\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/board?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			BoardHandler{MaxSize: 8}.ServeHTTP(w, r)
+			resp := w.Result()
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+			assert.Equal(t, tt.wantContentType, resp.Header.Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, string(body))
+		})
+	}
+}
+
+func TestBoardHandler_svg(t *testing.T) {
+	srv := httptest.NewServer(BoardHandler{MaxSize: MaxServeSize})
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/board?h=8&w=8&format=svg")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "image/svg+xml", resp.Header.Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(string(body), "<svg xmlns=\"http://www.w3.org/2000/svg\""))
+	assert.Equal(t, 64, strings.Count(string(body), "<rect"))
+}
+
+func Test_fenWithin(t *testing.T) {
+	assert.True(t, fenWithin("8/8 w - - 100 80", 8))
+	assert.True(t, fenWithin(" ", 8))
+	assert.False(t, fenWithin("09", 8))
+	assert.False(t, fenWithin("99999999999999999999", 8))
+	assert.False(t, fenWithin(strings.Repeat("p", 73), 8))
+}
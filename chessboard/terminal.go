@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+var (
+	// ErrColorMode indicates that program called with unknown color mode.
+	ErrColorMode = errors.New("color should be one of auto, none, 256, truecolor")
+	// ErrColorLayout indicates that painted squares are requested with frame, labels or cell height.
+	ErrColorLayout = errors.New("color can not be combined with frame, labels or cell height")
+)
+
+func validColorMode(mode string) bool {
+	switch mode {
+	case "", "auto", "none", "256", "truecolor":
+		return true
+	default:
+		return false
+	}
+}
+
+// colorMode return terminal color mode for color parameter, empty mode is none
+// so squares are painted only when asked, auto mode paints them only when writer is a terminal.
+func colorMode(mode string, w io.Writer, getenv func(string) string) board.ColorMode {
+	switch mode {
+	case "256":
+		return board.Color256
+	case "truecolor":
+		return board.TrueColor
+	case "auto":
+		if !isTerminal(w) || getenv("NO_COLOR") != "" || getenv("TERM") == "dumb" {
+			return board.NoColor
+		}
+		if ct := getenv("COLORTERM"); ct == "truecolor" || ct == "24bit" {
+			return board.TrueColor
+		}
+		return board.Color256
+	default:
+		return board.NoColor
+	}
+}
+
+// validColorLayout reports whether text layout parameters can be drawn with painted squares,
+// painted squares have no frame, labels or cell height.
+func validColorLayout(p *Parameters) bool {
+	return p.Color == "" || p.Color == "none" || !p.Frame && !p.Labels && p.CellHeight <= 1
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// rawMode switches terminal of file to raw input without echo with stty,
+// returned function restores previous terminal settings.
+func rawMode(f *os.File) (func() error, error) {
+	stty := func(args ...string) ([]byte, error) {
+		cmd := exec.Command("stty", args...)
+		cmd.Stdin = f
+		return cmd.Output()
+	}
+	state, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("saving terminal settings:%w", err)
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, fmt.Errorf("setting raw terminal:%w", err)
+	}
+	return func() error {
+		_, err := stty(strings.TrimSpace(string(state)))
+		return err
+	}, nil
+}
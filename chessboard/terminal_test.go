@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_colorMode(t *testing.T) {
+	tty, err := os.Open(os.DevNull)
+	assert.NoError(t, err)
+	defer tty.Close()
+
+	type args struct {
+		mode string
+		w    io.Writer
+		env  map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want board.ColorMode
+	}{
+		{"default", args{"", tty, nil}, board.NoColor},
+		{"none", args{"none", tty, nil}, board.NoColor},
+		{"forced 256", args{"256", &bytes.Buffer{}, nil}, board.Color256},
+		{"forced truecolor", args{"truecolor", &bytes.Buffer{}, nil}, board.TrueColor},
+		{"auto not terminal", args{"auto", &bytes.Buffer{}, nil}, board.NoColor},
+		{"auto terminal", args{"auto", tty, nil}, board.Color256},
+		{"auto truecolor terminal", args{"auto", tty, map[string]string{"COLORTERM": "24bit"}}, board.TrueColor},
+		{"auto no color", args{"auto", tty, map[string]string{"NO_COLOR": "1"}}, board.NoColor},
+		{"auto dumb terminal", args{"auto", tty, map[string]string{"TERM": "dumb"}}, board.NoColor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.args.env[key] }
+			assert.Equal(t, tt.want, colorMode(tt.args.mode, tt.args.w, getenv))
+		})
+	}
+}
+
+func Test_isTerminal(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	assert.NoError(t, err)
+	defer f.Close()
+	assert.False(t, isTerminal(f))
+	assert.False(t, isTerminal(&bytes.Buffer{}))
+
+	assert.NoError(t, f.Close())
+	assert.False(t, isTerminal(f))
+}
+
+func Test_rawMode(t *testing.T) {
+	f, err := os.Open(os.DevNull)
+	assert.NoError(t, err)
+	defer f.Close()
+	restore, err := rawMode(f)
+	assert.Error(t, err)
+	assert.Nil(t, restore)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/igkostyuk/dp210/chessboard/tour"
+)
+
+// TourParameters represent knight's tour command parameters.
+type TourParameters struct {
+	Height int
+	Width  int
+	// Start is a name of starting square, empty for top left square.
+	Start  string
+	Closed bool
+	Frame  bool
+	Labels bool
+}
+
+func parseTourParameters(args []string) (*TourParameters, error) {
+	p := &TourParameters{}
+	fs := flag.NewFlagSet("tour", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.Start, "start", "", "starting square like a1")
+	fs.BoolVar(&p.Closed, "closed", false, "find closed tour")
+	fs.BoolVar(&p.Frame, "frame", false, "draw text frame")
+	fs.BoolVar(&p.Labels, "labels", false, "draw text rank and file labels")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	height, width, err := parseSize(fs.Args())
+	if err != nil {
+		return nil, err
+	}
+	p.Height, p.Width = height, width
+	if p.Start != "" {
+		if _, _, err := board.ParseSquare(height, width, p.Start); err != nil {
+			return nil, fmt.Errorf("parse param start:%w", err)
+		}
+	}
+
+	return p, nil
+}
+
+func runTour(_ io.Reader, w io.Writer, args []string) error {
+	p, err := parseTourParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing tour parameters:%w", err)
+	}
+	return Tour(w, p)
+}
+
+// Tour write board with knight's tour move numbers with tour parameters.
+func Tour(w io.Writer, p *TourParameters) error {
+	row, col := 0, 0
+	if p.Start != "" {
+		var err error
+		if row, col, err = board.ParseSquare(p.Height, p.Width, p.Start); err != nil {
+			return fmt.Errorf("tour start:%w", err)
+		}
+	}
+	t, err := tour.Find(p.Height, p.Width, row, col, p.Closed)
+	if err != nil {
+		return fmt.Errorf("tour finding:%w", err)
+	}
+	b, err := t.Board()
+	if err != nil {
+		return fmt.Errorf("tour creating board:%w", err)
+	}
+	return b.WriteText(w, board.TextOptions{Frame: p.Frame, Labels: p.Labels})
+}
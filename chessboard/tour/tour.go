@@ -0,0 +1,287 @@
+// Package tour finds knight's tours on rectangular boards.
+package tour
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+// SearchLimit is a number of squares backtracking visits before giving up.
+const SearchLimit = 4000000
+
+var (
+	// ErrNoTour indicates that a knight's tour does not exist.
+	ErrNoTour = errors.New("knight's tour does not exist")
+	// ErrSearchLimit indicates that a tour was not found within search limit.
+	ErrSearchLimit = errors.New("knight's tour search limit exceeded")
+)
+
+// Tour represent knight move numbers by squares, starting square has number 1.
+type Tour [][]int
+
+var knightMoves = [8][2]int{{-2, -1}, {-2, 1}, {-1, -2}, {-1, 2}, {1, -2}, {1, 2}, {2, -1}, {2, 1}}
+
+// Exists reports whether board with height and width has open or closed tour from some square.
+func Exists(height, width int, closed bool) bool {
+	m, n := height, width
+	if m > n {
+		m, n = n, m
+	}
+	if closed {
+		return (m%2 == 0 || n%2 == 0) && m != 1 && m != 2 && m != 4 && !(m == 3 && (n == 4 || n == 6 || n == 8))
+	}
+	switch {
+	case m == 1:
+		return n == 1
+	case m == 2:
+		return false
+	case m == 3:
+		return n != 3 && n != 5 && n != 6
+	case m == 4:
+		return n != 4
+	}
+	return true
+}
+
+// Find return knight's tour of board with height and width starting at row and col,
+// closed tour ends with a knight move to the starting square.
+// It uses Warnsdorff's rule and backtracks when the rule runs into a dead end.
+func Find(height, width, row, col int, closed bool) (Tour, error) {
+	if height <= 0 || width <= 0 {
+		return nil, fmt.Errorf("tour board %dx%d:%w", height, width, board.ErrSize)
+	}
+	if row < 0 || row >= height || col < 0 || col >= width {
+		return nil, fmt.Errorf("tour start %d,%d:%w", row, col, board.ErrSquare)
+	}
+	kind := "open"
+	if closed {
+		kind = "closed"
+	}
+	if !Exists(height, width, closed) {
+		return nil, fmt.Errorf("%s tour on %dx%d board:%w", kind, height, width, ErrNoTour)
+	}
+	if !closed && height*width%2 == 1 && (row+col)%2 == 1 {
+		return nil, fmt.Errorf("open tour on %dx%d board from minority color square:%w", height, width, ErrNoTour)
+	}
+	// outer lines squares of 4 lines board lead only to inner ones, so tour has to
+	// alternate them strictly when it starts inside and meet outer squares of one color only.
+	if !closed && (height == 4 && row%3 != 0 || width == 4 && col%3 != 0) {
+		return nil, fmt.Errorf("open tour on %dx%d board from inner line square:%w", height, width, ErrNoTour)
+	}
+
+	s := newSearcher(height, width, closed)
+	s.startRow, s.startCol = row, col
+	if closed {
+		// every square of closed tour can be its start, so the tour is searched
+		// from corner where Warnsdorff's rule works best and renumbered.
+		s.startRow, s.startCol = 0, 0
+	}
+	found, err := s.restarts()
+	if err != nil {
+		return nil, fmt.Errorf("%s tour on %dx%d board:%w", kind, height, width, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("%s tour on %dx%d board from %d,%d:%w", kind, height, width, row, col, ErrNoTour)
+	}
+	if closed {
+		s.renumber(row, col)
+	}
+	return s.tour, nil
+}
+
+// restarts runs searches with doubling budgets, first search breaks Warnsdorff's rule ties
+// by distance from center and next ones randomly. Open search finished within its budget
+// visited all paths and proves that there is no tour.
+func (s *searcher) restarts() (bool, error) {
+	budget, left := 16*s.height*s.width, SearchLimit
+	for attempt := 0; left > 0; attempt++ {
+		if budget > left {
+			budget = left
+		}
+		left -= budget
+		s.budget = budget
+		if attempt > 0 {
+			s.rand = rand.New(rand.NewSource(int64(attempt)))
+		}
+		found := s.search(s.startRow, s.startCol, 1)
+		switch {
+		case found && (!s.closed || s.close(rand.New(rand.NewSource(int64(attempt))))):
+			return true, nil
+		case found:
+			s.clear()
+		case s.budget >= 0:
+			return false, nil
+		}
+		budget *= 2
+	}
+	return false, ErrSearchLimit
+}
+
+// searcher finds tour with ordered backtracking.
+type searcher struct {
+	height   int
+	width    int
+	closed   bool
+	tour     Tour
+	budget   int
+	startRow int
+	startCol int
+	// rand breaks ties of Warnsdorff's rule when it is not nil.
+	rand *rand.Rand
+}
+
+func newSearcher(height, width int, closed bool) *searcher {
+	tour := make(Tour, height)
+	for i := range tour {
+		tour[i] = make([]int, width)
+	}
+	return &searcher{height: height, width: width, closed: closed, tour: tour}
+}
+
+type candidate struct {
+	row, col, degree, distance int
+}
+
+// search visits square with move number n and continues the tour from it.
+func (s *searcher) search(row, col, n int) bool {
+	s.tour[row][col] = n
+	if n == s.height*s.width {
+		return true
+	}
+	if s.budget--; s.budget < 0 {
+		s.tour[row][col] = 0
+		return false
+	}
+	cs := s.candidates(row, col)
+	if len(cs) > 0 && cs[0].degree == 0 && n+1 < s.height*s.width {
+		// square reachable only from here has to be the last one.
+		cs = nil
+	}
+	for _, c := range cs {
+		if s.search(c.row, c.col, n+1) {
+			return true
+		}
+		if s.budget < 0 {
+			break
+		}
+	}
+	s.tour[row][col] = 0
+	return false
+}
+
+// candidates return unvisited squares reachable from square ordered by Warnsdorff's rule,
+// ties are broken by preferring squares far from board center.
+func (s *searcher) candidates(row, col int) []candidate {
+	var cs []candidate
+	for _, m := range knightMoves {
+		r, c := row+m[0], col+m[1]
+		if !s.inside(r, c) || s.tour[r][c] != 0 {
+			continue
+		}
+		dr, dc := 2*r-s.height+1, 2*c-s.width+1
+		cs = append(cs, candidate{row: r, col: c, degree: s.degree(r, c, 0), distance: dr*dr + dc*dc})
+	}
+	if s.rand != nil {
+		s.rand.Shuffle(len(cs), func(i, j int) { cs[i], cs[j] = cs[j], cs[i] })
+	}
+	sort.SliceStable(cs, func(i, j int) bool {
+		if cs[i].degree != cs[j].degree || s.rand != nil {
+			return cs[i].degree < cs[j].degree
+		}
+		return cs[i].distance > cs[j].distance
+	})
+	return cs
+}
+
+// close turns open tour into closed one with Pósa rotations: when the last square is
+// a knight move away from square k, path after k is reversed and gets a new last square.
+func (s *searcher) close(r *rand.Rand) bool {
+	total := s.height * s.width
+	path := make([][2]int, total)
+	for i, row := range s.tour {
+		for j, n := range row {
+			path[n-1] = [2]int{i, j}
+		}
+	}
+	for ; s.budget >= 0; s.budget-- {
+		end := path[total-1]
+		if s.degree(end[0], end[1], 1) > 0 {
+			return true
+		}
+		var pivots []int
+		for _, m := range knightMoves {
+			if row, col := end[0]+m[0], end[1]+m[1]; s.inside(row, col) && s.tour[row][col] < total-1 {
+				pivots = append(pivots, s.tour[row][col])
+			}
+		}
+		k := pivots[r.Intn(len(pivots))]
+		if r.Intn(2) == 0 {
+			// rotation at the first square of reversed path.
+			k = 0
+		}
+		for i, j := k, total-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+		for i := k; i < total; i++ {
+			s.tour[path[i][0]][path[i][1]] = i + 1
+		}
+	}
+	return false
+}
+
+func (s *searcher) clear() {
+	for _, row := range s.tour {
+		for j := range row {
+			row[j] = 0
+		}
+	}
+}
+
+// degree return number of squares with move number n reachable from square.
+func (s *searcher) degree(row, col, n int) int {
+	d := 0
+	for _, m := range knightMoves {
+		if r, c := row+m[0], col+m[1]; s.inside(r, c) && s.tour[r][c] == n {
+			d++
+		}
+	}
+	return d
+}
+
+func (s *searcher) inside(row, col int) bool {
+	return row >= 0 && row < s.height && col >= 0 && col < s.width
+}
+
+// renumber shifts closed tour move numbers to start from square at row and col.
+func (s *searcher) renumber(row, col int) {
+	total, shift := s.height*s.width, s.tour[row][col]-1
+	for _, r := range s.tour {
+		for j := range r {
+			r[j] = (r[j]-1-shift+total)%total + 1
+		}
+	}
+}
+
+// Board return board with move numbers right aligned in squares.
+func (t Tour) Board() (*board.Board, error) {
+	height := len(t)
+	if height == 0 {
+		return nil, board.ErrSize
+	}
+	br, err := board.NewBoard(height, len(t[0]), board.BlackSymbol, board.WhiteSymbol)
+	if err != nil {
+		return nil, err
+	}
+	digits := len(strconv.Itoa(br.Height * br.Width))
+	for i, r := range t {
+		for j, n := range r {
+			br.Squares[i][j] = fmt.Sprintf("%*d", digits+1, n)
+		}
+	}
+	return br, nil
+}
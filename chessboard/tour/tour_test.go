@@ -0,0 +1,135 @@
+package tour
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExists(t *testing.T) {
+	type args struct {
+		height int
+		width  int
+		closed bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"single square open", args{1, 1, false}, true},
+		{"single square closed", args{1, 1, true}, false},
+		{"two lines", args{2, 8, false}, false},
+		{"three by four open", args{3, 4, false}, true},
+		{"three by five open", args{5, 3, false}, false},
+		{"four by four open", args{4, 4, false}, false},
+		{"four by five open", args{4, 5, false}, true},
+		{"odd sides closed", args{5, 5, true}, false},
+		{"four lines closed", args{4, 6, true}, false},
+		{"three by eight closed", args{3, 8, true}, false},
+		{"three by ten closed", args{10, 3, true}, true},
+		{"five by six closed", args{5, 6, true}, true},
+		{"chess board closed", args{8, 8, true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Exists(tt.args.height, tt.args.width, tt.args.closed))
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	type args struct {
+		height int
+		width  int
+		row    int
+		col    int
+		closed bool
+	}
+	tests := []struct {
+		name      string
+		args      args
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"single square", args{1, 1, 0, 0, false}, assert.NoError},
+		{"three by four", args{3, 4, 0, 0, false}, assert.NoError},
+		{"five by five", args{5, 5, 2, 2, false}, assert.NoError},
+		{"chess board", args{8, 8, 3, 4, false}, assert.NoError},
+		{"chess board closed", args{8, 8, 7, 1, true}, assert.NoError},
+		{"three by ten closed", args{3, 10, 1, 5, true}, assert.NoError},
+		{"five by six closed", args{5, 6, 0, 0, true}, assert.NoError},
+		{"large closed", args{40, 41, 20, 20, true}, assert.NoError},
+		{"large open", args{60, 61, 1, 1, false}, assert.NoError},
+		{"no tour for sizes", args{4, 4, 0, 0, false}, assert.Error},
+		{"no closed tour for sizes", args{5, 7, 0, 0, true}, assert.Error},
+		{"minority color start", args{5, 5, 0, 1, false}, assert.Error},
+		{"inner line start", args{4, 9, 1, 4, false}, assert.Error},
+		{"no tour from start", args{3, 7, 1, 3, false}, assert.Error},
+		{"invalid size", args{0, 4, 0, 0, false}, assert.Error},
+		{"start outside board", args{8, 8, 8, 0, false}, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Find(tt.args.height, tt.args.width, tt.args.row, tt.args.col, tt.args.closed)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assertTour(t, got, tt.args.row, tt.args.col, tt.args.closed)
+		})
+	}
+}
+
+func TestFind_errors(t *testing.T) {
+	_, err := Find(4, 4, 0, 0, false)
+	assert.ErrorIs(t, err, ErrNoTour)
+	_, err = Find(3, 7, 1, 3, false)
+	assert.ErrorIs(t, err, ErrNoTour)
+	assert.EqualError(t, err, "open tour on 3x7 board from 1,3:knight's tour does not exist")
+}
+
+func TestTour_Board(t *testing.T) {
+	tr := Tour{{1, 4, 7, 10}, {12, 9, 2, 5}, {3, 6, 11, 8}}
+	br, err := tr.Board()
+	assert.NoError(t, err)
+	assert.Equal(t, ""+
+		"  1  4  7 10\n"+
+		" 12  9  2  5\n"+
+		"  3  6 11  8\n", br.String())
+
+	_, err = Tour{}.Board()
+	assert.Error(t, err)
+}
+
+// assertTour checks that every square is visited once by knight moves starting at row and col.
+func assertTour(t *testing.T, tr Tour, row, col int, closed bool) {
+	t.Helper()
+	height, width := len(tr), len(tr[0])
+	squares := make([][2]int, height*width+1)
+	for i, r := range tr {
+		for j, n := range r {
+			if !assert.True(t, n >= 1 && n <= height*width, "move %d", n) {
+				return
+			}
+			squares[n] = [2]int{i, j}
+		}
+	}
+	assert.Equal(t, [2]int{row, col}, squares[1])
+	isKnightMove := func(a, b [2]int) bool {
+		dr, dc := a[0]-b[0], a[1]-b[1]
+		return dr*dr+dc*dc == 5
+	}
+	for n := 2; n <= height*width; n++ {
+		assert.True(t, isKnightMove(squares[n-1], squares[n]), "move %d", n)
+	}
+	if closed {
+		assert.True(t, isKnightMove(squares[height*width], squares[1]), "closing move")
+	}
+}
+
+func BenchmarkFind(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Find(8, 8, 0, 0, true)
+	}
+}
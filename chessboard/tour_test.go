@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/tour"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseTourParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *TourParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"size only", args{[]string{"5", "5"}},
+			&TourParameters{Height: 5, Width: 5}, assert.NoError,
+		},
+		{
+			"all flags", args{[]string{"-start", "c3", "-closed", "-frame", "-labels", "6", "6"}},
+			&TourParameters{Height: 6, Width: 6, Start: "c3", Closed: true, Frame: true, Labels: true}, assert.NoError,
+		},
+		{"start outside board", args{[]string{"-start", "f1", "5", "5"}}, nil, assert.Error},
+		{"missing width", args{[]string{"5"}}, nil, assert.Error},
+		{"invalid width", args{[]string{"5", "-5"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown", "5", "5"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTourParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTour(t *testing.T) {
+	type args struct {
+		p *TourParameters
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"three by four",
+			args{&TourParameters{Height: 3, Width: 4}},
+			"  1  4  7 10\n" +
+				" 12  9  2  5\n" +
+				"  3  6 11  8\n", assert.NoError,
+		},
+		{
+			"start and labels",
+			args{&TourParameters{Height: 3, Width: 4, Start: "a1", Labels: true}},
+			"3   3  6 11  8\n" +
+				"2  12  9  2  5\n" +
+				"1   1  4  7 10\n" +
+				"   a  b  c  d \n", assert.NoError,
+		},
+		{
+			"no tour",
+			args{&TourParameters{Height: 2, Width: 5}},
+			"", assert.Error,
+		},
+		{
+			"invalid start",
+			args{&TourParameters{Height: 3, Width: 4, Start: "z9"}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Tour(w, tt.args.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func TestTour_noTour(t *testing.T) {
+	err := Tour(&bytes.Buffer{}, &TourParameters{Height: 4, Width: 4, Closed: true})
+	assert.True(t, errors.Is(err, tour.ErrNoTour))
+	assert.EqualError(t, err, "tour finding:closed tour on 4x4 board:knight's tour does not exist")
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+)
+
+var (
+	// ErrTransform indicates that a value is not a valid board transformation.
+	ErrTransform = errors.New("transformation should be rotate <degrees>, mirror h|v, transpose or crop <square>:<square>")
+)
+
+// transformsFlag collects board transformations in command line order, like rotate 90 or crop a1:b2.
+type transformsFlag []string
+
+// add return flag setter appending transformation with name and flag value.
+func (t *transformsFlag) add(name string) func(string) error {
+	return func(v string) error {
+		if err := validTransform(name, v); err != nil {
+			return err
+		}
+		*t = append(*t, strings.TrimSpace(name+" "+v))
+		return nil
+	}
+}
+
+// addBool return bool flag setter appending transformation with name when flag is true.
+func (t *transformsFlag) addBool(name string) boolFunc {
+	return func(v string) error {
+		set, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%s %q:%w", name, v, ErrTransform)
+		}
+		if set {
+			*t = append(*t, name)
+		}
+		return nil
+	}
+}
+
+// boolFunc is a bool flag calling function with its value.
+type boolFunc func(string) error
+
+func (f boolFunc) String() string     { return "" }
+func (f boolFunc) Set(v string) error { return f(v) }
+func (f boolFunc) IsBoolFlag() bool   { return true }
+
+func validTransform(name, value string) error {
+	switch name {
+	case "rotate":
+		degrees, err := strconv.Atoi(value)
+		if err != nil || degrees%90 != 0 {
+			return fmt.Errorf("rotate %q:%w", value, board.ErrRotation)
+		}
+	case "mirror":
+		if value != "h" && value != "v" {
+			return fmt.Errorf("mirror %q:%w", value, ErrTransform)
+		}
+	case "crop":
+		if strings.Count(value, ":") != 1 {
+			return fmt.Errorf("crop %q:%w", value, ErrTransform)
+		}
+	}
+	return nil
+}
+
+// transform return board changed by transformations in order.
+func transform(b *board.Board, transforms []string) (*board.Board, error) {
+	for _, t := range transforms {
+		name, value := t, ""
+		if i := strings.IndexByte(t, ' '); i >= 0 {
+			name, value = t[:i], t[i+1:]
+		}
+		if err := validTransform(name, value); err != nil {
+			return nil, err
+		}
+		var err error
+		switch name {
+		case "rotate":
+			degrees, _ := strconv.Atoi(value)
+			b, err = b.Rotate(degrees)
+		case "mirror":
+			if value == "h" {
+				b = b.MirrorHorizontal()
+			} else {
+				b = b.MirrorVertical()
+			}
+		case "transpose":
+			b = b.Transpose()
+		case "crop":
+			b, err = crop(b, value)
+		default:
+			err = fmt.Errorf("%q:%w", t, ErrTransform)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// crop return window of board between corner squares written like b2:e5.
+func crop(b *board.Board, window string) (*board.Board, error) {
+	corners := strings.Split(window, ":")
+	r1, c1, err := board.ParseSquare(b.Height, b.Width, corners[0])
+	if err != nil {
+		return nil, fmt.Errorf("crop:%w", err)
+	}
+	r2, c2, err := board.ParseSquare(b.Height, b.Width, corners[1])
+	if err != nil {
+		return nil, fmt.Errorf("crop:%w", err)
+	}
+	if r1 > r2 {
+		r1, r2 = r2, r1
+	}
+	if c1 > c2 {
+		c1, c2 = c2, c1
+	}
+	return b.Crop(r1, c1, r2-r1+1, c2-c1+1)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/igkostyuk/dp210/chessboard/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseParameters_transforms(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		want      transformsFlag
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"command line order",
+			[]string{"-crop", "a1:c3", "-rotate", "90", "-transpose", "-mirror", "v", "-transpose=false", "3", "4"},
+			transformsFlag{"crop a1:c3", "rotate 90", "transpose", "mirror v"}, assert.NoError,
+		},
+		{"invalid rotation", []string{"-rotate", "45", "3", "4"}, nil, assert.Error},
+		{"invalid mirror", []string{"-mirror", "d", "3", "4"}, nil, assert.Error},
+		{"invalid crop", []string{"-crop", "a1", "3", "4"}, nil, assert.Error},
+		{"invalid transpose", []string{"-transpose=maybe", "3", "4"}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseParameters(tt.args)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got.Transforms)
+		})
+	}
+}
+
+func Test_transform(t *testing.T) {
+	b := &board.Board{Height: 2, Width: 3, Squares: [][]string{{"a", "b", "c"}, {"d", "e", "f"}}}
+	tests := []struct {
+		name       string
+		transforms []string
+		want       string
+		assertion  assert.ErrorAssertionFunc
+	}{
+		{"none", nil, "abc\ndef\n", assert.NoError},
+		{"rotate", []string{"rotate 90"}, "da\neb\nfc\n", assert.NoError},
+		{"mirror", []string{"mirror h", "mirror v"}, "fed\ncba\n", assert.NoError},
+		{"transpose", []string{"transpose"}, "ad\nbe\ncf\n", assert.NoError},
+		{"crop", []string{"crop c2:b1"}, "bc\nef\n", assert.NoError},
+		{"crop after rotation", []string{"rotate 90", "crop a3:b3"}, "da\n", assert.NoError},
+		{"crop outside board", []string{"crop a1:d1"}, "", assert.Error},
+		{"unknown", []string{"shear 2"}, "", assert.Error},
+		{"invalid rotation", []string{"rotate x"}, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transform(b, tt.transforms)
+			tt.assertion(t, err)
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestTask_transforms(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         *Parameters
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"black perspective",
+			&Parameters{FEN: "k2/3/2K", Transforms: transformsFlag{"rotate 180"}},
+			"K *\n * \n* k\n", assert.NoError,
+		},
+		{
+			"rotated plain board keeps colors",
+			&Parameters{Height: 2, Width: 3, Transforms: transformsFlag{"rotate 90"}, Labels: true},
+			"3  *\n2 * \n1  *\n  ab\n", assert.NoError,
+		},
+		{
+			"crop of pattern",
+			&Parameters{Height: 5, Width: 5, Pattern: "rings", Transforms: transformsFlag{"crop b2:d4"}},
+			"   \n * \n   \n", assert.NoError,
+		},
+		{
+			"crop outside board",
+			&Parameters{Height: 2, Width: 2, Transforms: transformsFlag{"crop a1:c3"}},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Task(w, tt.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
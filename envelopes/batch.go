@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/igkostyuk/dp210/envelopes/envelope"
+)
+
+var (
+	// ErrFormat indicates that batch command called with unknown input or output format.
+	ErrFormat = errors.New("input should be csv or json and output should be table or json")
+)
+
+// BatchParameters represent batch command parameters.
+type BatchParameters struct {
+	File string
+	// Input is a format of envelopes csv or json, empty to detect it by file extension or content.
+	Input  string
+	Output string
+	// Fits limits results to pairs where inner envelope fits in outer one or fits within tolerance.
+	Fits bool
+	// Clearance is a room required on each side of inner envelope.
+	Clearance float64
+	// Tolerance is a room difference from clearance small enough to report fit within tolerance.
+	Tolerance float64
+}
+
+// fitResult represent fit check of ordered envelopes pair.
+type fitResult struct {
+	inner *envelope.Envelope
+	outer *envelope.Envelope
+	fit   envelope.Fit
+}
+
+// fitResultJSON represent fit check JSON object with envelope names.
+type fitResultJSON struct {
+	Inner string `json:"inner"`
+	Outer string `json:"outer"`
+	Fits  bool   `json:"fits"`
+	// WithinTolerance is set when inner envelope fits only within tolerance.
+	WithinTolerance bool `json:"within_tolerance,omitempty"`
+}
+
+func parseBatchParameters(args []string) (*BatchParameters, error) {
+	p := &BatchParameters{}
+	fs := flag.NewFlagSet("batch", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.Input, "input", "", "envelopes format: csv or json")
+	fs.StringVar(&p.Output, "output", "table", "results format: table or json")
+	fs.BoolVar(&p.Fits, "fits", false, "print only pairs that fit or fit within tolerance")
+	fs.Func("clearance", "room required on each side of inner envelope", sizeFlag(&p.Clearance))
+	fs.Func("tolerance", "room difference from clearance reported as fitting within tolerance", sizeFlag(&p.Tolerance))
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if p.Input != "" && p.Input != "csv" && p.Input != "json" {
+		return nil, fmt.Errorf("parse param input: %w", ErrFormat)
+	}
+	if p.Output != "table" && p.Output != "json" {
+		return nil, fmt.Errorf("parse param output: %w", ErrFormat)
+	}
+	if p.Clearance < 0 {
+		return nil, fmt.Errorf("parse param clearance: %w", envelope.ErrFitOptions)
+	}
+	if p.Tolerance < 0 {
+		return nil, fmt.Errorf("parse param tolerance: %w", envelope.ErrFitOptions)
+	}
+	if fs.NArg() > 1 {
+		return nil, fmt.Errorf("batch arguments %v:%w", fs.Args(), ErrParameters)
+	}
+	p.File = fs.Arg(0)
+
+	return p, nil
+}
+
+// sizeFlag return flag parser of size with optional unit.
+func sizeFlag(size *float64) func(string) error {
+	return func(text string) error {
+		s, err := envelope.ParseSize(text)
+		*size = s.Millimetres()
+		return err
+	}
+}
+
+func runBatch(r io.Reader, w io.Writer, args []string) error {
+	p, err := parseBatchParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing batch parameters:%w", err)
+	}
+	if p.File != "" && p.File != "-" {
+		f, err := os.Open(p.File)
+		if err != nil {
+			return fmt.Errorf("opening envelopes file:%w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	return Batch(r, w, p)
+}
+
+// Batch write fit results of every ordered pair of envelopes read from reader with batch parameters.
+func Batch(r io.Reader, w io.Writer, p *BatchParameters) error {
+	envs, err := readEnvelopes(r, p.File, p.Input)
+	if err != nil {
+		return fmt.Errorf("batch:%w", err)
+	}
+	o := envelope.FitOptions{Clearance: p.Clearance, Tolerance: p.Tolerance}
+	results := make([]fitResult, 0)
+	for _, inner := range envs {
+		for _, outer := range envs {
+			if inner == outer {
+				continue
+			}
+			fit, err := inner.FitIn(outer, o)
+			if err != nil {
+				return fmt.Errorf("batch:%w", err)
+			}
+			if fit != envelope.DoesNotFit || !p.Fits {
+				results = append(results, fitResult{inner, outer, fit})
+			}
+		}
+	}
+	if p.Output == "json" {
+		docs := make([]fitResultJSON, len(results))
+		for i, res := range results {
+			docs[i] = fitResultJSON{
+				Inner:           res.inner.Name,
+				Outer:           res.outer.Name,
+				Fits:            res.fit == envelope.Fits,
+				WithinTolerance: res.fit == envelope.FitsWithinTolerance,
+			}
+		}
+		if err := json.NewEncoder(w).Encode(docs); err != nil {
+			return fmt.Errorf("writing json results:%w", err)
+		}
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "inner\touter\tfits")
+	for _, res := range results {
+		fits := "no"
+		switch res.fit {
+		case envelope.Fits:
+			fits = "yes"
+		case envelope.FitsWithinTolerance:
+			fits = "within tolerance"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", res.inner, res.outer, fits)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("writing results table:%w", err)
+	}
+	return nil
+}
+
+// readEnvelopes read envelopes in csv or json format, format is detected
+// by file extension or by first non-space character of input when it is not set.
+func readEnvelopes(r io.Reader, file, format string) ([]*envelope.Envelope, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+	br := bufio.NewReader(r)
+	if format != "csv" && format != "json" {
+		format = "csv"
+		for {
+			c, _, err := br.ReadRune()
+			if err != nil {
+				break
+			}
+			if !unicode.IsSpace(c) {
+				if c == '[' {
+					format = "json"
+				}
+				br.UnreadRune()
+				break
+			}
+		}
+	}
+	if format == "json" {
+		return envelope.ReadJSON(br)
+	}
+	return envelope.ReadCSV(br)
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/igkostyuk/dp210/envelopes/envelope"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseBatchParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *BatchParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"defaults", args{nil}, &BatchParameters{Output: "table"}, assert.NoError},
+		{
+			"all flags", args{[]string{"-input", "json", "-output", "json", "-fits", "envelopes.txt"}},
+			&BatchParameters{File: "envelopes.txt", Input: "json", Output: "json", Fits: true}, assert.NoError,
+		},
+		{
+			"fit options", args{[]string{"-clearance", "2", "-tolerance", "0.01"}},
+			&BatchParameters{Output: "table", Clearance: 2, Tolerance: 0.01}, assert.NoError,
+		},
+		{
+			"fit options with units", args{[]string{"-clearance", "0.2cm", "-tolerance", "0.1 mm"}},
+			&BatchParameters{Output: "table", Clearance: 2, Tolerance: 0.1}, assert.NoError,
+		},
+		{"invalid clearance", args{[]string{"-clearance", "2ft"}}, nil, assert.Error},
+		{"negative clearance", args{[]string{"-clearance", "-2"}}, nil, assert.Error},
+		{"negative tolerance", args{[]string{"-tolerance", "-0.01"}}, nil, assert.Error},
+		{"unknown input", args{[]string{"-input", "xml"}}, nil, assert.Error},
+		{"unknown output", args{[]string{"-output", "csv"}}, nil, assert.Error},
+		{"two files", args{[]string{"a.csv", "b.csv"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBatchParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	csvInput := "name,height,width\nDL,110,220\nC5,162,229\n"
+	jsonInput := ` [{"name":"DL","height":110,"width":220},{"name":"C5","height":162,"width":229}]`
+	tests := []struct {
+		name      string
+		input     string
+		p         *BatchParameters
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"csv table", csvInput, &BatchParameters{Output: "table"},
+			"inner              outer              fits\n" +
+				"DL(110.00,220.00)  C5(162.00,229.00)  yes\n" +
+				"C5(162.00,229.00)  DL(110.00,220.00)  no\n",
+			assert.NoError,
+		},
+		{
+			"detected json", jsonInput, &BatchParameters{Output: "json"},
+			`[{"inner":"DL","outer":"C5","fits":true},{"inner":"C5","outer":"DL","fits":false}]` + "\n",
+			assert.NoError,
+		},
+		{
+			"only fits", csvInput, &BatchParameters{Output: "json", Fits: true},
+			`[{"inner":"DL","outer":"C5","fits":true}]` + "\n",
+			assert.NoError,
+		},
+		{
+			"diagonal fit", "long,1,10\nsquare,9,9\n", &BatchParameters{Output: "table", Fits: true},
+			"inner             outer              fits\n" +
+				"long(1.00,10.00)  square(9.00,9.00)  yes\n",
+			assert.NoError,
+		},
+		{
+			"clearance", csvInput, &BatchParameters{Output: "table", Clearance: 2},
+			"inner              outer              fits\n" +
+				"DL(110.00,220.00)  C5(162.00,229.00)  yes\n" +
+				"C5(162.00,229.00)  DL(110.00,220.00)  no\n",
+			assert.NoError,
+		},
+		{
+			"clearance within tolerance", csvInput, &BatchParameters{Output: "table", Clearance: 4.5, Tolerance: 0.1},
+			"inner              outer              fits\n" +
+				"DL(110.00,220.00)  C5(162.00,229.00)  within tolerance\n" +
+				"C5(162.00,229.00)  DL(110.00,220.00)  no\n",
+			assert.NoError,
+		},
+		{
+			"clearance within tolerance json", csvInput, &BatchParameters{Output: "json", Fits: true, Clearance: 4.5, Tolerance: 0.1},
+			`[{"inner":"DL","outer":"C5","fits":false,"within_tolerance":true}]` + "\n",
+			assert.NoError,
+		},
+		{
+			"same envelope in different units", "DL,110mm,220\nDL,4.33in,8.66\n",
+			&BatchParameters{Output: "table", Tolerance: envelope.SizeTolerance},
+			"inner                  outer                  fits\n" +
+				"DL(110.00mm,220.00mm)  DL(4.33in,8.66in)      no\n" +
+				"DL(4.33in,8.66in)      DL(110.00mm,220.00mm)  within tolerance\n",
+			assert.NoError,
+		},
+		{
+			"slightly bigger envelope json", "DL,110,220\nDL+,110.2,220.2\n", &BatchParameters{Output: "json"},
+			`[{"inner":"DL","outer":"DL+","fits":true},{"inner":"DL+","outer":"DL","fits":false}]` + "\n",
+			assert.NoError,
+		},
+		{
+			"too big clearance", csvInput, &BatchParameters{Output: "json", Fits: true, Clearance: 5},
+			"[]\n", assert.NoError,
+		},
+		{
+			"no envelopes", "", &BatchParameters{Output: "json"},
+			"[]\n", assert.NoError,
+		},
+		{
+			"forced csv format", jsonInput, &BatchParameters{Input: "csv", Output: "table"},
+			"", assert.Error,
+		},
+		{
+			"invalid size", "DL,110,-1\n", &BatchParameters{Output: "table"},
+			"", assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Batch(strings.NewReader(tt.input), w, tt.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
+func Test_runBatch_file(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "envelopes.json")
+	assert.NoError(t, os.WriteFile(name, []byte(`[{"name":"a","height":1,"width":1},{"name":"b","height":2,"width":2}]`), 0o600))
+
+	w := &bytes.Buffer{}
+	assert.NoError(t, runBatch(strings.NewReader(""), w, []string{"-fits", "-output", "json", name}))
+	assert.Equal(t, `[{"inner":"a","outer":"b","fits":true}]`+"\n", w.String())
+
+	assert.Error(t, runBatch(strings.NewReader(""), w, []string{filepath.Join(dir, "missing.csv")}))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/igkostyuk/dp210/envelopes/envelope"
+)
+
+// ChainParameters represent chain command parameters.
+type ChainParameters struct {
+	File string
+	// Input is a format of envelopes csv or json, empty to detect it by file extension or content.
+	Input    string
+	Diagonal bool
+}
+
+func parseChainParameters(args []string) (*ChainParameters, error) {
+	p := &ChainParameters{}
+	fs := flag.NewFlagSet("chain", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&p.Input, "input", "", "envelopes format: csv or json")
+	fs.BoolVar(&p.Diagonal, "diagonal", false, "allow envelopes to fit diagonally")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("%v:%w", err, ErrParameters)
+	}
+	if p.Input != "" && p.Input != "csv" && p.Input != "json" {
+		return nil, fmt.Errorf("parse param input: %w", ErrFormat)
+	}
+	if fs.NArg() > 1 {
+		return nil, fmt.Errorf("chain arguments %v:%w", fs.Args(), ErrParameters)
+	}
+	p.File = fs.Arg(0)
+
+	return p, nil
+}
+
+func runChain(r io.Reader, w io.Writer, args []string) error {
+	p, err := parseChainParameters(args)
+	if err != nil {
+		return fmt.Errorf("parsing chain parameters:%w", err)
+	}
+	if p.File != "" && p.File != "-" {
+		f, err := os.Open(p.File)
+		if err != nil {
+			return fmt.Errorf("opening envelopes file:%w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	return Chain(r, w, p)
+}
+
+// Chain write longest chain of nested envelopes read from reader with chain parameters,
+// starting from the innermost envelope.
+func Chain(r io.Reader, w io.Writer, p *ChainParameters) error {
+	envs, err := readEnvelopes(r, p.File, p.Input)
+	if err != nil {
+		return fmt.Errorf("chain:%w", err)
+	}
+	chain := envelope.LongestChain(envs, p.Diagonal)
+	if len(chain) == 0 {
+		fmt.Fprintln(w, "no envelopes")
+		return nil
+	}
+	for i, e := range chain {
+		fmt.Fprintf(w, "%d. %s\n", i+1, e)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseChainParameters(t *testing.T) {
+	type args struct {
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *ChainParameters
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"defaults", args{nil}, &ChainParameters{}, assert.NoError},
+		{
+			"all flags", args{[]string{"-input", "json", "-diagonal", "envelopes.txt"}},
+			&ChainParameters{File: "envelopes.txt", Input: "json", Diagonal: true}, assert.NoError,
+		},
+		{"unknown input", args{[]string{"-input", "xml"}}, nil, assert.Error},
+		{"two files", args{[]string{"a.csv", "b.csv"}}, nil, assert.Error},
+		{"unknown flag", args{[]string{"-unknown"}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChainParameters(tt.args.args)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	input := "name,height,width\nbig,10,10\nlong,10,1\nsquare,9,9\nsmall,1,1\n"
+	tests := []struct {
+		name      string
+		input     string
+		p         *ChainParameters
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"aligned", input, &ChainParameters{},
+			"1. small(1.00,1.00)\n2. square(9.00,9.00)\n3. big(10.00,10.00)\n", assert.NoError,
+		},
+		{
+			"diagonal", input, &ChainParameters{Diagonal: true},
+			"1. small(1.00,1.00)\n2. long(10.00,1.00)\n3. square(9.00,9.00)\n4. big(10.00,10.00)\n", assert.NoError,
+		},
+		{"no envelopes", "", &ChainParameters{}, "no envelopes\n", assert.NoError},
+		{"invalid input", "big,10\n", &ChainParameters{}, "", assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, Chain(strings.NewReader(tt.input), w, tt.p))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package envelope
+
+import "sort"
+
+// LongestChain return longest sequence of envelopes where every envelope fits in the next one.
+// Without diagonal fits envelopes are nested with parallel sides and chain is found
+// in O(n log n) time, with them chain is the longest path of O(n²) fits graph.
+func LongestChain(envs []*Envelope, diagonal bool) []*Envelope {
+	if diagonal {
+		return longestFitsPath(envs)
+	}
+	return longestAlignedChain(envs)
+}
+
+// longestAlignedChain finds longest subsequence of envelopes sorted by long side
+// with non-decreasing short sides, envelopes with equal long sides can not be nested,
+// so their chain positions are found before any of them is added to chain tails.
+func longestAlignedChain(envs []*Envelope) []*Envelope {
+	order := make([]int, len(envs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		si, li := envs[order[i]].sides()
+		sj, lj := envs[order[j]].sides()
+		if li != lj {
+			return li < lj
+		}
+		return si > sj
+	})
+
+	// tails[k] is an envelope with the smallest short side ending chain of length k+1.
+	tails := make([]int, 0)
+	prev := make([]int, len(envs))
+	for start := 0; start < len(order); {
+		_, long := envs[order[start]].sides()
+		end := start
+		positions := make([]int, 0)
+		for ; end < len(order); end++ {
+			short, l := envs[order[end]].sides()
+			if l != long {
+				break
+			}
+			k := sort.Search(len(tails), func(k int) bool {
+				s, _ := envs[tails[k]].sides()
+				return s > short
+			})
+			prev[order[end]] = -1
+			if k > 0 {
+				prev[order[end]] = tails[k-1]
+			}
+			positions = append(positions, k)
+		}
+		for i, k := range positions {
+			if k == len(tails) {
+				tails = append(tails, order[start+i])
+				continue
+			}
+			tails[k] = order[start+i]
+		}
+		start = end
+	}
+	if len(tails) == 0 {
+		return nil
+	}
+	return chainOf(envs, prev, tails[len(tails)-1], len(tails))
+}
+
+// longestFitsPath finds longest path of graph with edges from envelopes to envelopes they fit in,
+// fitting envelope has smaller area, so envelopes ordered by area are topologically sorted.
+func longestFitsPath(envs []*Envelope) []*Envelope {
+	order := make([]int, len(envs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return envs[order[i]].Height*envs[order[i]].Width < envs[order[j]].Height*envs[order[j]].Width
+	})
+	length, prev := make([]int, len(envs)), make([]int, len(envs))
+	best := -1
+	for i, outer := range order {
+		length[outer], prev[outer] = 1, -1
+		for _, inner := range order[:i] {
+			if length[inner]+1 > length[outer] && envs[inner].IsFitsIn(envs[outer]) {
+				length[outer], prev[outer] = length[inner]+1, inner
+			}
+		}
+		if best < 0 || length[outer] > length[best] {
+			best = outer
+		}
+	}
+	if best < 0 {
+		return nil
+	}
+	return chainOf(envs, prev, best, length[best])
+}
+
+// chainOf return chain of length ending with envelope last following prev links back.
+func chainOf(envs []*Envelope, prev []int, last, length int) []*Envelope {
+	chain := make([]*Envelope, length)
+	for i := length - 1; i >= 0; i-- {
+		chain[i], last = envs[last], prev[last]
+	}
+	return chain
+}
@@ -0,0 +1,96 @@
+package envelope
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLongestChain(t *testing.T) {
+	a := &Envelope{Name: "a", Height: 1, Width: 2}
+	b := &Envelope{Name: "b", Height: 3, Width: 2}
+	c := &Envelope{Name: "c", Height: 3, Width: 4}
+	wide := &Envelope{Name: "wide", Height: 1, Width: 5}
+	long := &Envelope{Name: "long", Height: 10, Width: 1}
+	square := &Envelope{Name: "square", Height: 9, Width: 9}
+	big := &Envelope{Name: "big", Height: 10, Width: 10}
+	type args struct {
+		envs     []*Envelope
+		diagonal bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*Envelope
+	}{
+		{"empty", args{nil, false}, nil},
+		{"single", args{[]*Envelope{a}, false}, []*Envelope{a}},
+		{"rotated envelopes", args{[]*Envelope{c, wide, a, b}, false}, []*Envelope{a, b, c}},
+		{"equal envelopes", args{[]*Envelope{a, a, a}, false}, []*Envelope{a}},
+		{"equal short sides", args{[]*Envelope{b, {Name: "d", Height: 2, Width: 4}}, false}, []*Envelope{b, {Name: "d", Height: 2, Width: 4}}},
+		{"equal long sides", args{[]*Envelope{{Name: "e", Height: 2, Width: 4}, c}, false}, []*Envelope{{Name: "e", Height: 2, Width: 4}}},
+		{"slightly bigger envelope", args{[]*Envelope{{Name: "f", Height: 1.1, Width: 1.1}, {Name: "g", Height: 1, Width: 1}}, false}, []*Envelope{{Name: "g", Height: 1, Width: 1}, {Name: "f", Height: 1.1, Width: 1.1}}},
+		{"aligned without diagonal", args{[]*Envelope{big, long, square}, false}, []*Envelope{square, big}},
+		{"diagonal", args{[]*Envelope{big, long, square}, true}, []*Envelope{long, square, big}},
+		{"diagonal equal envelopes", args{[]*Envelope{a, a}, true}, []*Envelope{a}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LongestChain(tt.args.envs, tt.args.diagonal))
+		})
+	}
+}
+
+func TestLongestChain_random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n < 300; n++ {
+		envs := make([]*Envelope, rng.Intn(12))
+		for i := range envs {
+			envs[i] = &Envelope{Height: float64(1 + rng.Intn(6)), Width: float64(1 + rng.Intn(6))}
+		}
+		for _, diagonal := range []bool{false, true} {
+			fits := (*Envelope).IsFitsInAligned
+			if diagonal {
+				fits = (*Envelope).IsFitsIn
+			}
+			chain := LongestChain(envs, diagonal)
+			for i := 1; i < len(chain); i++ {
+				assert.True(t, fits(chain[i-1], chain[i]), "%v in %v", chain[i-1], chain[i])
+			}
+			assert.Equal(t, longestByBruteForce(envs, fits), len(chain), "%v diagonal %t", envs, diagonal)
+		}
+	}
+}
+
+// longestByBruteForce return longest chain length of envelopes trying all orders depth first.
+func longestByBruteForce(envs []*Envelope, fits func(e, fe *Envelope) bool) int {
+	var longest func(last int, used []bool) int
+	longest = func(last int, used []bool) int {
+		best := 0
+		for i, e := range envs {
+			if used[i] || last >= 0 && !fits(envs[last], e) {
+				continue
+			}
+			used[i] = true
+			if l := 1 + longest(i, used); l > best {
+				best = l
+			}
+			used[i] = false
+		}
+		return best
+	}
+	return longest(-1, make([]bool, len(envs)))
+}
+
+func BenchmarkLongestChain(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	envs := make([]*Envelope, 100000)
+	for i := range envs {
+		envs[i] = &Envelope{Height: 1 + rng.Float64()*1000, Width: 1 + rng.Float64()*1000}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LongestChain(envs, false)
+	}
+}
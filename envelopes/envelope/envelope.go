@@ -6,16 +6,24 @@ import (
 	"math"
 )
 
+// SizeTolerance is a fit tolerance in millimetres for sizes given in inches with two decimals,
+// they differ from sizes in millimetres up to 0.127 mm.
+const SizeTolerance = 0.15
+
 var (
 	// ErrSizeSyntax indicates that a value does not have the right syntax for the size type.
 	ErrSizeSyntax = errors.New("size should be positive float")
+	// ErrUnit indicates that a unit is not mm, cm or in.
+	ErrUnit = errors.New("unit should be mm, cm or in")
 )
 
-// Envelope represent envelope.
+// Envelope represent envelope with sizes in millimetres.
 type Envelope struct {
 	Name   string
 	Height float64
 	Width  float64
+	// Unit is a unit envelope sizes are printed in.
+	Unit Unit
 }
 
 // NewEnvelope create envelope with name height width sizes.
@@ -26,9 +34,42 @@ func NewEnvelope(name string, height, width float64) (*Envelope, error) {
 	return &Envelope{Name: name, Height: height, Width: width}, nil
 }
 
+// NewEnvelopeFromSizes create envelope with name height width sizes, size without unit
+// has unit of the other size and envelope is printed in height unit or width unit.
+func NewEnvelopeFromSizes(name string, height, width Size) (*Envelope, error) {
+	for _, s := range []Size{height, width} {
+		if _, ok := units[s.Unit]; !ok {
+			return nil, fmt.Errorf("envelope unit %q:%w", s.Unit, ErrUnit)
+		}
+	}
+	unit := height.Unit.Or(width.Unit)
+	height.Unit, width.Unit = height.Unit.Or(unit), width.Unit.Or(unit)
+	env, err := NewEnvelope(name, height.Millimetres(), width.Millimetres())
+	if err != nil {
+		return nil, err
+	}
+	env.Unit = unit
+	return env, nil
+}
+
 // String return string representation of envelope.
 func (e *Envelope) String() string {
-	return fmt.Sprintf("%s(%.2f,%.2f)", e.Name, e.Height, e.Width)
+	return fmt.Sprintf("%s(%s,%s)", e.Name, e.Unit.Format(e.Height), e.Unit.Format(e.Width))
+}
+
+// IsFitsInAligned indicate if envelope can fit in argument envelope with sides parallel to its sides.
+func (e *Envelope) IsFitsInAligned(fe *Envelope) bool {
+	q, p := e.sides()
+	b, a := fe.sides()
+	return q <= b && p < a
+}
+
+// sides return short and long sides of envelope.
+func (e *Envelope) sides() (float64, float64) {
+	if e.Height > e.Width {
+		return e.Width, e.Height
+	}
+	return e.Height, e.Width
 }
 
 // IsFitsIn indicate if envelope can fit in argument envelope.
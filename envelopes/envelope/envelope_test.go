@@ -40,6 +40,49 @@ func TestNewEnvelope(t *testing.T) {
 	}
 }
 
+func TestNewEnvelopeFromSizes(t *testing.T) {
+	type args struct {
+		height Size
+		width  Size
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *Envelope
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"without units", args{Size{Value: 1.5}, Size{Value: 2}},
+			&Envelope{Name: "DL", Height: 1.5, Width: 2}, assert.NoError,
+		},
+		{
+			"centimetres", args{Size{11, Centimetre}, Size{22, Centimetre}},
+			&Envelope{Name: "DL", Height: 110, Width: 220, Unit: Centimetre}, assert.NoError,
+		},
+		{
+			"height without unit", args{Size{Value: 11}, Size{22, Centimetre}},
+			&Envelope{Name: "DL", Height: 110, Width: 220, Unit: Centimetre}, assert.NoError,
+		},
+		{
+			"width without unit", args{Size{2, Inch}, Size{Value: 3}},
+			&Envelope{Name: "DL", Height: 50.8, Width: Size{3, Inch}.Millimetres(), Unit: Inch}, assert.NoError,
+		},
+		{
+			"different units", args{Size{110, Millimetre}, Size{22, Centimetre}},
+			&Envelope{Name: "DL", Height: 110, Width: 220, Unit: Millimetre}, assert.NoError,
+		},
+		{"unknown unit", args{Size{110, "ft"}, Size{Value: 220}}, nil, assert.Error},
+		{"negative size", args{Size{-110, Inch}, Size{Value: 220}}, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEnvelopeFromSizes("DL", tt.args.height, tt.args.width)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestEnvelope_IsFitsIn(t *testing.T) {
 	type fields struct {
 		name   string
@@ -57,23 +100,27 @@ func TestEnvelope_IsFitsIn(t *testing.T) {
 	}{
 		{
 			"size bigger than argument size",
-			fields{"ab", 10, 10}, args{&Envelope{"cd", 5, 5}}, false,
+			fields{"ab", 10, 10}, args{&Envelope{Name: "cd", Height: 5, Width: 5}}, false,
 		},
 		{
 			"size smaller than argument size",
-			fields{"ab", 5, 5}, args{&Envelope{"cd", 10, 10}}, true,
+			fields{"ab", 5, 5}, args{&Envelope{Name: "cd", Height: 10, Width: 10}}, true,
 		},
 		{
 			"height bigger than argument size",
-			fields{"ab", 9, 4}, args{&Envelope{"cd", 5, 10}}, true,
+			fields{"ab", 9, 4}, args{&Envelope{Name: "cd", Height: 5, Width: 10}}, true,
 		},
 		{
 			"width bigger than argument size",
-			fields{"ab", 4, 9}, args{&Envelope{"cd", 10, 5}}, true,
+			fields{"ab", 4, 9}, args{&Envelope{Name: "cd", Height: 10, Width: 5}}, true,
 		},
 		{
 			"diagonal fit",
-			fields{"ab", 10, 1}, args{&Envelope{"cd", 9, 9}}, true,
+			fields{"ab", 10, 1}, args{&Envelope{Name: "cd", Height: 9, Width: 9}}, true,
+		},
+		{
+			"slightly smaller",
+			fields{"ab", 1, 1}, args{&Envelope{Name: "cd", Height: 1.1, Width: 1.1}}, true,
 		},
 	}
 	for _, tt := range tests {
@@ -88,11 +135,32 @@ func TestEnvelope_IsFitsIn(t *testing.T) {
 	}
 }
 
+func TestEnvelope_IsFitsInAligned(t *testing.T) {
+	tests := []struct {
+		name string
+		e    *Envelope
+		fe   *Envelope
+		want bool
+	}{
+		{"smaller", &Envelope{Name: "ab", Height: 5, Width: 5}, &Envelope{Name: "cd", Height: 10, Width: 10}, true},
+		{"rotated", &Envelope{Name: "ab", Height: 9, Width: 4}, &Envelope{Name: "cd", Height: 5, Width: 10}, true},
+		{"equal short side", &Envelope{Name: "ab", Height: 5, Width: 9}, &Envelope{Name: "cd", Height: 5, Width: 10}, true},
+		{"equal long side", &Envelope{Name: "ab", Height: 4, Width: 10}, &Envelope{Name: "cd", Height: 5, Width: 10}, false},
+		{"diagonal only", &Envelope{Name: "ab", Height: 10, Width: 1}, &Envelope{Name: "cd", Height: 9, Width: 9}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.e.IsFitsInAligned(tt.fe))
+		})
+	}
+}
+
 func TestEnvelope_String(t *testing.T) {
 	type fields struct {
 		name   string
 		height float64
 		width  float64
+		unit   Unit
 	}
 	tests := []struct {
 		name   string
@@ -109,6 +177,11 @@ func TestEnvelope_String(t *testing.T) {
 			fields{name: "test", height: 1.2345, width: 1.2345},
 			"test(1.23,1.23)",
 		},
+		{
+			"inches",
+			fields{name: "test", height: 110, width: 220, unit: Inch},
+			"test(4.33in,8.66in)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -116,6 +189,7 @@ func TestEnvelope_String(t *testing.T) {
 				Name:   tt.fields.name,
 				Height: tt.fields.height,
 				Width:  tt.fields.width,
+				Unit:   tt.fields.unit,
 			}
 			assert.Equal(t, tt.want, e.String())
 		})
@@ -0,0 +1,67 @@
+package envelope
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrFitOptions indicates that fit options have negative clearance or tolerance.
+	ErrFitOptions = errors.New("clearance and tolerance should be non-negative")
+)
+
+// Fit represent result of envelope fit check with clearance and tolerance.
+type Fit int
+
+// Fit results.
+const (
+	DoesNotFit Fit = iota
+	FitsWithinTolerance
+	Fits
+)
+
+// String return fit result description.
+func (f Fit) String() string {
+	switch f {
+	case Fits:
+		return "fits"
+	case FitsWithinTolerance:
+		return "fits only within tolerance"
+	}
+	return "does not fit"
+}
+
+// FitOptions represent fit check clearance and tolerance.
+type FitOptions struct {
+	// Clearance is a room required between envelope and every side of outer envelope.
+	Clearance float64
+	// Tolerance is a room difference from clearance small enough to report fit only within tolerance.
+	Tolerance float64
+}
+
+// FitIn check if envelope can fit in argument envelope leaving clearance on each side,
+// envelope fits when it fits with tolerance to spare and fits only within tolerance
+// when its room differs from clearance by less than tolerance, so results near equality
+// do not depend on floating point error. Room is never bigger than outer envelope,
+// so without clearance envelope fits within tolerance only when it really fits.
+func (e *Envelope) FitIn(fe *Envelope, o FitOptions) (Fit, error) {
+	if o.Clearance < 0 || o.Tolerance < 0 {
+		return DoesNotFit, ErrFitOptions
+	}
+	switch {
+	case e.fitsWithMargin(fe, o.Clearance+o.Tolerance):
+		return Fits, nil
+	case e.fitsWithMargin(fe, math.Max(o.Clearance-o.Tolerance, 0)):
+		return FitsWithinTolerance, nil
+	}
+	return DoesNotFit, nil
+}
+
+// fitsWithMargin indicate if envelope can fit in argument envelope with margin on each side.
+func (e *Envelope) fitsWithMargin(fe *Envelope, margin float64) bool {
+	room := &Envelope{Height: fe.Height - 2*margin, Width: fe.Width - 2*margin}
+	if room.Height <= 0 || room.Width <= 0 {
+		return false
+	}
+	return e.IsFitsIn(room)
+}
@@ -0,0 +1,78 @@
+package envelope
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelope_FitIn(t *testing.T) {
+	small := &Envelope{Name: "small", Height: 1, Width: 1}
+	large := &Envelope{Name: "large", Height: 2, Width: 2}
+	type args struct {
+		e  *Envelope
+		fe *Envelope
+		o  FitOptions
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      Fit
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"fits", args{small, large, FitOptions{}}, Fits, assert.NoError},
+		{"fits with clearance", args{small, large, FitOptions{Clearance: 0.4, Tolerance: 0.01}}, Fits, assert.NoError},
+		{"clearance within tolerance", args{small, large, FitOptions{Clearance: 0.5, Tolerance: 0.01}}, FitsWithinTolerance, assert.NoError},
+		{"clearance bigger than tolerance", args{small, large, FitOptions{Clearance: 0.6, Tolerance: 0.01}}, DoesNotFit, assert.NoError},
+		{"clearance bigger than envelope", args{small, large, FitOptions{Clearance: 1}}, DoesNotFit, assert.NoError},
+		{"equal envelopes", args{small, small, FitOptions{}}, DoesNotFit, assert.NoError},
+		{"equal envelopes with tolerance", args{small, small, FitOptions{Tolerance: 1e-9}}, DoesNotFit, assert.NoError},
+		{"bigger envelope with tolerance", args{&Envelope{Name: "bigger", Height: 1.1, Width: 1.1}, small, FitOptions{Tolerance: 0.15}}, DoesNotFit, assert.NoError},
+		{"fits within tolerance without clearance", args{small, &Envelope{Name: "bigger", Height: 1.1, Width: 1.1}, FitOptions{Tolerance: 0.15}}, FitsWithinTolerance, assert.NoError},
+		{"diagonal", args{&Envelope{Name: "long", Height: 10, Width: 1}, &Envelope{Name: "square", Height: 9, Width: 9}, FitOptions{Clearance: 0.1}}, Fits, assert.NoError},
+		{"negative clearance", args{small, large, FitOptions{Clearance: -1}}, DoesNotFit, assert.Error},
+		{"negative tolerance", args{small, large, FitOptions{Tolerance: -1}}, DoesNotFit, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.args.e.FitIn(tt.args.fe, tt.args.o)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEnvelope_FitIn_diagonalBorder(t *testing.T) {
+	// the long envelope placed diagonally touches sides of square with side 11/√2 ≈ 7.7782.
+	long := &Envelope{Name: "long", Height: 10, Width: 1}
+	o := FitOptions{Tolerance: 0.01}
+	for side, want := range map[float64]Fit{7.75: DoesNotFit, 7.77: DoesNotFit, 7.79: FitsWithinTolerance, 7.8: Fits} {
+		got, err := long.FitIn(&Envelope{Name: "square", Height: side, Width: side}, o)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, "square side %v", side)
+	}
+}
+
+func TestEnvelope_FitIn_noOptions(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		e := &Envelope{Name: "e", Height: 1 + 9*rng.Float64(), Width: 1 + 9*rng.Float64()}
+		fe := &Envelope{Name: "fe", Height: 1 + 9*rng.Float64(), Width: 1 + 9*rng.Float64()}
+		want := DoesNotFit
+		if e.IsFitsIn(fe) {
+			want = Fits
+		}
+		got, err := e.FitIn(fe, FitOptions{})
+		assert.NoError(t, err)
+		if !assert.Equal(t, want, got, "%v in %v", e, fe) {
+			return
+		}
+	}
+}
+
+func TestFit_String(t *testing.T) {
+	assert.Equal(t, "fits", Fits.String())
+	assert.Equal(t, "fits only within tolerance", FitsWithinTolerance.String())
+	assert.Equal(t, "does not fit", DoesNotFit.String())
+}
@@ -0,0 +1,73 @@
+package envelope
+
+import (
+	"fmt"
+	"math"
+)
+
+// Placement represent position of envelope inside outer envelope.
+type Placement struct {
+	// Angle is a counterclockwise rotation of envelope in degrees from its sides orientation,
+	// height along outer envelope height and width along outer envelope width.
+	Angle float64
+	// X and Y are coordinates of envelope center from bottom left corner of outer envelope.
+	X float64
+	Y float64
+}
+
+// PlacementIn return placement of envelope in argument envelope,
+// it reports false when envelope does not fit in it.
+func (e *Envelope) PlacementIn(fe *Envelope) (Placement, bool) {
+	if !e.IsFitsIn(fe) {
+		return Placement{}, false
+	}
+	pl := Placement{X: fe.Width / 2, Y: fe.Height / 2}
+	if e.IsFitsInAligned(fe) {
+		if e.Width > fe.Width || e.Height > fe.Height {
+			pl.Angle = 90
+		}
+		return pl, true
+	}
+	// long side of envelope is turned along long side of outer envelope and tilted
+	// by the smallest angle making its bounding box as long as outer envelope:
+	// p·cos θ + q·sin θ = a, the bounding box short side is the smallest at this angle.
+	q, p := e.sides()
+	_, a := fe.sides()
+	tilt := math.Atan2(q, p) + math.Acos(a/math.Hypot(p, q))
+	angle := tilt * 180 / math.Pi
+	if e.Height > e.Width {
+		angle += 90
+	}
+	if fe.Height > fe.Width {
+		angle += 90
+	}
+	pl.Angle = math.Mod(angle, 180)
+	return pl, true
+}
+
+// Corners return corners coordinates of envelope placed in outer envelope.
+func (pl Placement) Corners(e *Envelope) [4][2]float64 {
+	sin, cos := math.Sincos(pl.Angle * math.Pi / 180)
+	var corners [4][2]float64
+	for i, c := range [4][2]float64{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}} {
+		x, y := c[0]*e.Width/2, c[1]*e.Height/2
+		corners[i] = [2]float64{pl.X + x*cos - y*sin, pl.Y + x*sin + y*cos}
+	}
+	return corners
+}
+
+// String return placement description like axis-aligned, rotated 90°.
+func (pl Placement) String() string {
+	return pl.Format("")
+}
+
+// Format return placement description with center coordinates in unit.
+func (pl Placement) Format(u Unit) string {
+	switch pl.Angle {
+	case 0:
+		return "axis-aligned"
+	case 90:
+		return "axis-aligned, rotated 90°"
+	}
+	return fmt.Sprintf("diagonal, rotated %.2f° around center at (%s,%s)", pl.Angle, u.Format(pl.X), u.Format(pl.Y))
+}
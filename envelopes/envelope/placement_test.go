@@ -0,0 +1,75 @@
+package envelope
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelope_PlacementIn(t *testing.T) {
+	tests := []struct {
+		name   string
+		e      *Envelope
+		fe     *Envelope
+		want   string
+		wantOk bool
+	}{
+		{"aligned", &Envelope{Name: "a", Height: 1, Width: 2}, &Envelope{Name: "b", Height: 3, Width: 4}, "axis-aligned", true},
+		{"rotated", &Envelope{Name: "a", Height: 4, Width: 1}, &Envelope{Name: "b", Height: 3, Width: 5}, "axis-aligned, rotated 90°", true},
+		{"diagonal", &Envelope{Name: "long", Height: 10, Width: 1}, &Envelope{Name: "square", Height: 9, Width: 9}, "diagonal, rotated 122.13° around center at (4.50,4.50)", true},
+		{"does not fit", &Envelope{Name: "a", Height: 5, Width: 5}, &Envelope{Name: "b", Height: 3, Width: 4}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.e.PlacementIn(tt.fe)
+			assert.Equal(t, tt.wantOk, ok)
+			if ok {
+				assert.Equal(t, tt.want, got.String())
+				assertInside(t, tt.e, tt.fe, got)
+			}
+		})
+	}
+}
+
+func TestPlacement_Format(t *testing.T) {
+	pl := Placement{Angle: 30, X: 25.4, Y: 12.7}
+	assert.Equal(t, "diagonal, rotated 30.00° around center at (1.00in,0.50in)", pl.Format(Inch))
+	assert.Equal(t, "axis-aligned, rotated 90°", Placement{Angle: 90}.Format(Inch))
+}
+
+func TestEnvelope_PlacementIn_random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	fits := 0
+	for i := 0; i < 100000; i++ {
+		e := &Envelope{Name: "e", Height: 1 + 9*rng.Float64(), Width: 0.1 + rng.Float64()}
+		if rng.Intn(2) == 0 {
+			e.Height, e.Width = e.Width, e.Height
+		}
+		fe := &Envelope{Name: "fe", Height: 1 + 9*rng.Float64(), Width: 1 + 9*rng.Float64()}
+		pl, ok := e.PlacementIn(fe)
+		if ok != e.IsFitsIn(fe) {
+			t.Fatalf("%v in %v placement reports %v", e, fe, ok)
+		}
+		if !ok || e.IsFitsInAligned(fe) {
+			continue
+		}
+		fits++
+		if !assertInside(t, e, fe, pl) {
+			return
+		}
+	}
+	assert.NotZero(t, fits)
+}
+
+// assertInside asserts that all envelope corners lie inside outer envelope.
+func assertInside(t *testing.T, e, fe *Envelope, pl Placement) bool {
+	t.Helper()
+	const eps = 1e-9
+	for _, c := range pl.Corners(e) {
+		if c[0] < -eps || c[0] > fe.Width+eps || c[1] < -eps || c[1] > fe.Height+eps {
+			return assert.Failf(t, "corner outside", "%v in %v placed %v has corner %v", e, fe, pl, c)
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package envelope
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	// ErrRecord indicates that a record is not envelope name, height and width.
+	ErrRecord = errors.New("record should be name, height, width")
+)
+
+// ReadCSV read envelopes from CSV records of name, height and width,
+// first record can be a header with these names, sizes can have units
+// and size without unit has unit of the other size.
+func ReadCSV(r io.Reader) ([]*Envelope, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 3
+	cr.TrimLeadingSpace = true
+	envs := make([]*Envelope, 0)
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return envs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv envelopes:%w", err)
+		}
+		if line == 1 && strings.EqualFold(strings.Join(record, ","), "name,height,width") {
+			continue
+		}
+		height, herr := ParseSize(record[1])
+		width, werr := ParseSize(record[2])
+		if herr != nil || werr != nil {
+			return nil, fmt.Errorf("csv record %d:%w", line, ErrRecord)
+		}
+		env, err := NewEnvelopeFromSizes(record[0], height, width)
+		if err != nil {
+			return nil, fmt.Errorf("csv record %d:%w", line, err)
+		}
+		envs = append(envs, env)
+	}
+}
+
+// envelopeJSON represent envelope JSON object.
+type envelopeJSON struct {
+	Name   string    `json:"name"`
+	Height *sizeJSON `json:"height"`
+	Width  *sizeJSON `json:"width"`
+}
+
+// sizeJSON represent JSON size number or string with unit.
+type sizeJSON Size
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *sizeJSON) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return json.Unmarshal(data, &s.Value)
+	}
+	size, err := ParseSize(text)
+	if err != nil {
+		return fmt.Errorf("size %q:%w", text, ErrRecord)
+	}
+	*s = sizeJSON(size)
+	return nil
+}
+
+// ReadJSON read envelopes from JSON array of objects with name, height and width,
+// sizes are numbers or strings with units and size without unit has unit of the other size.
+func ReadJSON(r io.Reader) ([]*Envelope, error) {
+	var docs []envelopeJSON
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&docs); err != nil {
+		return nil, fmt.Errorf("reading json envelopes:%w", err)
+	}
+	envs := make([]*Envelope, 0, len(docs))
+	for i, d := range docs {
+		if d.Height == nil || d.Width == nil {
+			return nil, fmt.Errorf("json envelope %d:%w", i+1, ErrRecord)
+		}
+		env, err := NewEnvelopeFromSizes(d.Name, Size(*d.Height), Size(*d.Width))
+		if err != nil {
+			return nil, fmt.Errorf("json envelope %d:%w", i+1, err)
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
@@ -0,0 +1,105 @@
+package envelope
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      []*Envelope
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"with header",
+			"name,height,width\nDL,110,220\nC5, 162, 229\n",
+			[]*Envelope{{Name: "DL", Height: 110, Width: 220}, {Name: "C5", Height: 162, Width: 229}},
+			assert.NoError,
+		},
+		{
+			"without header",
+			"item,1.5,2\n",
+			[]*Envelope{{Name: "item", Height: 1.5, Width: 2}},
+			assert.NoError,
+		},
+		{
+			"with units",
+			"DL,110mm,220\nC5,16.2 cm,9.02in\nC6,4.49in,6.38\n",
+			[]*Envelope{
+				{Name: "DL", Height: 110, Width: 220, Unit: Millimetre},
+				{Name: "C5", Height: 162, Width: Size{9.02, Inch}.Millimetres(), Unit: Centimetre},
+				{Name: "C6", Height: Size{4.49, Inch}.Millimetres(), Width: Size{6.38, Inch}.Millimetres(), Unit: Inch},
+			},
+			assert.NoError,
+		},
+		{"empty", "", []*Envelope{}, assert.NoError},
+		{"invalid size", "DL,110,wide\n", nil, assert.Error},
+		{"unknown unit", "DL,110ft,220\n", nil, assert.Error},
+		{"negative size", "DL,110,220\nC5,-1,229\n", nil, assert.Error},
+		{"missing field", "DL,110\n", nil, assert.Error},
+		{"header after first record", "DL,110,220\nname,height,width\n", nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tt.input))
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadCSV_errorMessage(t *testing.T) {
+	_, err := ReadCSV(strings.NewReader("DL,110,220\nC5,-1,229\n"))
+	assert.EqualError(t, err, "csv record 2:size should be positive float")
+	assert.ErrorIs(t, err, ErrSizeSyntax)
+}
+
+func TestReadJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      []*Envelope
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"envelopes",
+			`[{"name":"DL","height":110,"width":220},{"name":"item","height":1.5,"width":2}]`,
+			[]*Envelope{{Name: "DL", Height: 110, Width: 220}, {Name: "item", Height: 1.5, Width: 2}},
+			assert.NoError,
+		},
+		{
+			"sizes with units",
+			`[{"name":"DL","height":"4.33in","width":"8.66 in"},{"name":"C5","height":6.38,"width":"9.02in"}]`,
+			[]*Envelope{
+				{Name: "DL", Height: Size{4.33, Inch}.Millimetres(), Width: Size{8.66, Inch}.Millimetres(), Unit: Inch},
+				{Name: "C5", Height: Size{6.38, Inch}.Millimetres(), Width: Size{9.02, Inch}.Millimetres(), Unit: Inch},
+			},
+			assert.NoError,
+		},
+		{"empty", `[]`, []*Envelope{}, assert.NoError},
+		{"unknown unit", `[{"name":"DL","height":"110ft","width":220}]`, nil, assert.Error},
+		{"size not number", `[{"name":"DL","height":true,"width":220}]`, nil, assert.Error},
+		{"missing width", `[{"name":"DL","height":110}]`, nil, assert.Error},
+		{"zero height", `[{"name":"DL","height":0,"width":220}]`, nil, assert.Error},
+		{"unknown field", `[{"name":"DL","height":1,"width":2,"depth":3}]`, nil, assert.Error},
+		{"not array", `{"name":"DL"}`, nil, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadJSON(strings.NewReader(tt.input))
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadJSON_readerError(t *testing.T) {
+	_, err := ReadJSON(iotest.ErrReader(errors.New("test")))
+	assert.Error(t, err)
+}
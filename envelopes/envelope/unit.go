@@ -0,0 +1,61 @@
+package envelope
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Unit represent length unit of sizes, empty unit is a size number without unit.
+type Unit string
+
+// Units of sizes.
+const (
+	Millimetre Unit = "mm"
+	Centimetre Unit = "cm"
+	Inch       Unit = "in"
+)
+
+// units maps units to their lengths in millimetres, envelope sizes are kept in millimetres.
+var units = map[Unit]float64{"": 1, Millimetre: 1, Centimetre: 10, Inch: 25.4}
+
+// Size represent length number in unit.
+type Size struct {
+	Value float64
+	Unit  Unit
+}
+
+// ParseSize parse size like 110mm, 4.33in, 22 cm or number without unit.
+func ParseSize(text string) (Size, error) {
+	text = strings.TrimSpace(text)
+	var unit Unit
+	for u := range units {
+		if u != "" && len(text) > len(u) && strings.EqualFold(text[len(text)-len(u):], string(u)) {
+			unit, text = u, strings.TrimSpace(text[:len(text)-len(u)])
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return Size{}, err
+	}
+	return Size{Value: value, Unit: unit}, nil
+}
+
+// Millimetres return size length in millimetres, number without unit is millimetres too.
+func (s Size) Millimetres() float64 {
+	return s.Value * units[s.Unit]
+}
+
+// Or return unit or argument unit when unit is empty.
+func (u Unit) Or(unit Unit) Unit {
+	if u == "" {
+		return unit
+	}
+	return u
+}
+
+// Format return size in millimetres converted to unit with unit symbol.
+func (u Unit) Format(size float64) string {
+	return fmt.Sprintf("%.2f%s", size/units[u], u)
+}
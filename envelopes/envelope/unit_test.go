@@ -0,0 +1,87 @@
+package envelope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		want      Size
+		assertion assert.ErrorAssertionFunc
+	}{
+		{"number", "1.5", Size{Value: 1.5}, assert.NoError},
+		{"millimetres", "110.04mm", Size{110.04, Millimetre}, assert.NoError},
+		{"inches", "4.33in", Size{4.33, Inch}, assert.NoError},
+		{"centimetres with space", " 22 cm", Size{22, Centimetre}, assert.NoError},
+		{"upper case unit", "22CM", Size{22, Centimetre}, assert.NoError},
+		{"negative", "-1mm", Size{-1, Millimetre}, assert.NoError},
+		{"unit only", "mm", Size{}, assert.Error},
+		{"unknown unit", "110ft", Size{}, assert.Error},
+		{"invalid number", "INVALID", Size{}, assert.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSize(tt.text)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSize_Millimetres(t *testing.T) {
+	assert.Equal(t, 110.04, Size{Value: 110.04}.Millimetres())
+	assert.Equal(t, 220.0, Size{22, Centimetre}.Millimetres())
+	assert.Equal(t, 50.8, Size{2, Inch}.Millimetres())
+}
+
+func TestEnvelope_unitsComparison(t *testing.T) {
+	envelopeOf := func(name, height, width string) *Envelope {
+		h, err := ParseSize(height)
+		assert.NoError(t, err)
+		w, err := ParseSize(width)
+		assert.NoError(t, err)
+		env, err := NewEnvelopeFromSizes(name, h, w)
+		assert.NoError(t, err)
+		return env
+	}
+	tests := []struct {
+		name string
+		mm   *Envelope
+		in   *Envelope
+	}{
+		{"DL", envelopeOf("DL", "110mm", "220mm"), envelopeOf("DL", "4.33in", "8.66in")},
+		{"C5", envelopeOf("C5", "162mm", "229mm"), envelopeOf("C5", "6.38in", "9.02in")},
+		{"C6", envelopeOf("C6", "114mm", "162mm"), envelopeOf("C6", "4.49in", "6.38in")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// exact sizes differ by less than a millimetre, so one envelope fits in the other.
+			assert.NotEqual(t, tt.mm.IsFitsIn(tt.in), tt.in.IsFitsIn(tt.mm))
+			for _, pair := range [][2]*Envelope{{tt.mm, tt.in}, {tt.in, tt.mm}} {
+				fit, err := pair[0].FitIn(pair[1], FitOptions{Tolerance: SizeTolerance})
+				assert.NoError(t, err)
+				assert.NotEqual(t, Fits, fit)
+			}
+		})
+	}
+	dl := envelopeOf("DL", "4.33in", "8.66in")
+	assert.Equal(t, "DL(4.33in,8.66in)", dl.String())
+	assert.Equal(t, "DL(109.98,219.96)", (&Envelope{Name: "DL", Height: dl.Height, Width: dl.Width}).String())
+}
+
+func TestUnit_Or(t *testing.T) {
+	assert.Equal(t, Inch, Unit("").Or(Inch))
+	assert.Equal(t, Millimetre, Millimetre.Or(Inch))
+	assert.Equal(t, Unit(""), Unit("").Or(""))
+}
+
+func TestUnit_Format(t *testing.T) {
+	assert.Equal(t, "1.50", Unit("").Format(1.5))
+	assert.Equal(t, "110.00mm", Millimetre.Format(110))
+	assert.Equal(t, "11.00cm", Centimetre.Format(110))
+	assert.Equal(t, "1.00in", Inch.Format(25.4))
+}
@@ -2,18 +2,23 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/igkostyuk/dp210/envelopes/envelope"
 )
 
+var (
+	// ErrParameters indicates that program called with unknown command or invalid arguments.
+	ErrParameters = errors.New("invalid parameters")
+)
+
 type size struct {
 	name  string
-	value float64
+	value envelope.Size
 }
 
 type sizePair [2]size
@@ -28,7 +33,7 @@ func getSizePairValues(r *bufio.Reader, w io.Writer, sp *sizePair) error {
 			return fmt.Errorf("reading input:%w", err)
 		}
 		text = strings.TrimSuffix(text, "\n")
-		sp[i].value, err = strconv.ParseFloat(text, 64)
+		sp[i].value, err = envelope.ParseSize(text)
 		if err != nil {
 			return fmt.Errorf("parsing size:%w", err)
 		}
@@ -44,7 +49,7 @@ func getEnvelops(r *bufio.Reader, w io.Writer, sps []sizePair) ([]*envelope.Enve
 		if err := getSizePairValues(r, w, &sp); err != nil {
 			return nil, err
 		}
-		env, err := envelope.NewEnvelope(name, sp[0].value, sp[1].value)
+		env, err := envelope.NewEnvelopeFromSizes(name, sp[0].value, sp[1].value)
 		if err != nil {
 			return nil, fmt.Errorf("get envelopes:%w", err)
 		}
@@ -97,7 +102,8 @@ func Task(r io.Reader, w io.Writer) {
 			fmt.Fprintln(w, "envelops can't fit")
 		default:
 			for _, ep := range eps {
-				fmt.Fprintf(w, "envelope %s can fit in %s\n", ep[0], ep[1])
+				pl, _ := ep[0].PlacementIn(ep[1])
+				fmt.Fprintf(w, "envelope %s can fit in %s: %s\n", ep[0], ep[1], pl.Format(ep[1].Unit))
 			}
 		}
 		fmt.Fprintf(w, "continue %v ?:", confirms)
@@ -105,11 +111,36 @@ func Task(r io.Reader, w io.Writer) {
 	}
 }
 
+// commands maps subcommand names to their runners.
+var commands = map[string]func(r io.Reader, w io.Writer, args []string) error{
+	"batch": runBatch,
+	"chain": runChain,
+}
+
+func run(r io.Reader, w io.Writer, args []string) error {
+	if len(args) == 0 {
+		usage(w)
+		Task(r, w)
+		return nil
+	}
+	if cmd, ok := commands[args[0]]; ok {
+		return cmd(r, w, args[1:])
+	}
+	return fmt.Errorf("command %q:%w", args[0], ErrParameters)
+}
+
 func usage(w io.Writer) {
 	fmt.Fprintf(w, "%s: checks if one envelope can fit in another\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s batch [-input csv|json] [-output table|json] [-fits] [-clearance <size>] [-tolerance <size>] [file]\n", os.Args[0])
+	fmt.Fprintf(w, "usage: %s chain [-input csv|json] [-diagonal] [file]\n", os.Args[0])
 }
 
 func main() {
-	usage(os.Stdout)
-	Task(os.Stdin, os.Stdout)
+	if err := run(os.Stdin, os.Stdout, os.Args[1:]); err != nil {
+		if errors.Is(err, ErrParameters) {
+			usage(os.Stdout)
+		}
+		fmt.Println(err)
+	}
 }
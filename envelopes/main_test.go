@@ -79,6 +79,22 @@ func Test_getEnvelops(t *testing.T) {
 			}, "Enter AB envelope sizes\nA: B: Enter CD envelope sizes\nC: D: ",
 			assert.NoError,
 		},
+		{
+			"values with units",
+			args{
+				bufio.NewReader(strings.NewReader("11\n22cm\n4.33 in\n8.66\n")),
+				[]sizePair{{{name: "A"}, {name: "B"}}, {{name: "C"}, {name: "D"}}},
+			}, []*envelope.Envelope{
+				{Name: "AB", Height: 110, Width: 220, Unit: envelope.Centimetre},
+				{
+					Name:   "CD",
+					Height: envelope.Size{Value: 4.33, Unit: envelope.Inch}.Millimetres(),
+					Width:  envelope.Size{Value: 8.66, Unit: envelope.Inch}.Millimetres(),
+					Unit:   envelope.Inch,
+				},
+			}, "Enter AB envelope sizes\nA: B: Enter CD envelope sizes\nC: D: ",
+			assert.NoError,
+		},
 		{
 			"invalid reader",
 			args{
@@ -193,7 +209,7 @@ func TestTask(t *testing.T) {
 				"Enter CD envelope sizes\n" +
 				"C: " +
 				"D: " +
-				"envelope AB(1.00,1.00) can fit in CD(2.00,2.00)\n" +
+				"envelope AB(1.00,1.00) can fit in CD(2.00,2.00): axis-aligned\n" +
 				"continue [y yes] ?:",
 		},
 		{
@@ -206,7 +222,34 @@ func TestTask(t *testing.T) {
 				"Enter CD envelope sizes\n" +
 				"C: " +
 				"D: " +
-				"envelope CD(1.00,1.00) can fit in AB(2.00,2.00)\n" +
+				"envelope CD(1.00,1.00) can fit in AB(2.00,2.00): axis-aligned\n" +
+				"continue [y yes] ?:",
+		},
+		{
+			"units",
+			args{
+				strings.NewReader("4.33in\n" + "8.66 in\n" + "16.2cm\n" + "229mm\n" + "no\n"),
+			}, "Enter AB envelope sizes\n" +
+				"A: " +
+				"B: " +
+				"Enter CD envelope sizes\n" +
+				"C: " +
+				"D: " +
+				"envelope AB(4.33in,8.66in) can fit in CD(16.20cm,22.90cm): axis-aligned\n" +
+				"continue [y yes] ?:",
+		},
+		{
+			"diagonal fit",
+			args{
+				strings.NewReader("10\n" + "1\n" + "9\n" + "9\n" + "no\n"),
+			}, "Enter AB envelope sizes\n" +
+				"A: " +
+				"B: " +
+				"Enter CD envelope sizes\n" +
+				"C: " +
+				"D: " +
+				"envelope AB(10.00,1.00) can fit in CD(9.00,9.00): " +
+				"diagonal, rotated 122.13° around center at (4.50,4.50)\n" +
 				"continue [y yes] ?:",
 		},
 		{
@@ -256,6 +299,54 @@ func TestTask(t *testing.T) {
 	}
 }
 
+func Test_run(t *testing.T) {
+	type args struct {
+		r    io.Reader
+		args []string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantW     string
+		assertion assert.ErrorAssertionFunc
+	}{
+		{
+			"interactive",
+			args{strings.NewReader("1\n1\n2\n2\nno\n"), nil},
+			"test: checks if one envelope can fit in another\n" +
+				"usage: test\n" +
+				"usage: test batch [-input csv|json] [-output table|json] [-fits] [-clearance <size>] [-tolerance <size>] [file]\n" +
+				"usage: test chain [-input csv|json] [-diagonal] [file]\n" +
+				"Enter AB envelope sizes\nA: B: Enter CD envelope sizes\nC: D: " +
+				"envelope AB(1.00,1.00) can fit in CD(2.00,2.00): axis-aligned\n" +
+				"continue [y yes] ?:",
+			assert.NoError,
+		},
+		{
+			"batch command",
+			args{strings.NewReader("a,1,1\nb,2,2\n"), []string{"batch", "-fits"}},
+			"inner         outer         fits\n" +
+				"a(1.00,1.00)  b(2.00,2.00)  yes\n",
+			assert.NoError,
+		},
+		{
+			"chain command",
+			args{strings.NewReader("a,1,1\nb,2,2\n"), []string{"chain"}},
+			"1. a(1.00,1.00)\n2. b(2.00,2.00)\n",
+			assert.NoError,
+		},
+		{"unknown command", args{strings.NewReader(""), []string{"unknown"}}, "", assert.Error},
+	}
+	os.Args[0] = "test"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			tt.assertion(t, run(tt.args.r, w, tt.args.args))
+			assert.Equal(t, tt.wantW, w.String())
+		})
+	}
+}
+
 func Test_usage(t *testing.T) {
 
 	os.Args[0] = "test"
@@ -264,7 +355,13 @@ func Test_usage(t *testing.T) {
 		name  string
 		wantW string
 	}{
-		{"usage", "test: checks if one envelope can fit in another\n"},
+		{
+			"usage",
+			"test: checks if one envelope can fit in another\n" +
+				"usage: test\n" +
+				"usage: test batch [-input csv|json] [-output table|json] [-fits] [-clearance <size>] [-tolerance <size>] [file]\n" +
+				"usage: test chain [-input csv|json] [-diagonal] [file]\n",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {